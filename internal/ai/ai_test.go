@@ -75,6 +75,22 @@ func TestDefaultConfig(t *testing.T) {
 	}
 }
 
+func TestConfigOverrideModel(t *testing.T) {
+	cfg := DefaultConfig()
+
+	cfg.OverrideModel("")
+	if cfg.Claude.Model != "haiku" {
+		t.Errorf("Claude.Model = %q, want %q (empty override must be a no-op)", cfg.Claude.Model, "haiku")
+	}
+
+	cfg.OverrideModel("sonnet")
+	for name, pc := range map[string]ProviderConfig{"claude": cfg.Claude, "codex": cfg.Codex, "gemini": cfg.Gemini} {
+		if pc.Model != "sonnet" {
+			t.Errorf("%s.Model = %q, want %q", name, pc.Model, "sonnet")
+		}
+	}
+}
+
 func TestNewClient(t *testing.T) {
 	cfg := DefaultConfig()
 
@@ -25,6 +25,10 @@ type Config struct {
 	// Timeout for CLI execution
 	Timeout time.Duration `yaml:"timeout"`
 
+	// Retries is how often a request is retried after a rate limit
+	// (0 = no retry)
+	Retries int `yaml:"retries"`
+
 	// TemplatesDir is the custom prompt templates directory
 	TemplatesDir string `yaml:"templates_dir"`
 }
@@ -50,7 +54,20 @@ func DefaultConfig() *Config {
 			Bin: "gemini",
 		},
 		Timeout: 60 * time.Second,
+		Retries: DefaultRetries,
+	}
+}
+
+// OverrideModel sets the model for every provider, so the override applies
+// regardless of which provider is selected or auto-detected.
+// An empty model leaves the configured per-provider models unchanged.
+func (c *Config) OverrideModel(model string) {
+	if model == "" {
+		return
 	}
+	c.Claude.Model = model
+	c.Codex.Model = model
+	c.Gemini.Model = model
 }
 
 // LoadConfig loads the AI configuration from the default path.
@@ -119,15 +119,19 @@ Rules:
 - Must start and end with ---
 - Required fields: number, title, state, labels, assignees, created_at, updated_at
 - Extract number from filename if missing (e.g., "158-feat..." → number: 158)
-- state must be one of: open, wip, done, closed
+- state must be one of: {{.valid_states}}
 - labels and assignees should be arrays (use [] if empty)
 - Dates should be in YYYY-MM-DD format
 
 Current content:
 {{.content}}
-
+{{if .all_issues}}
+Other valid issues in this project (number: title):
+{{.all_issues}}
+Use these to pick a number that does not conflict with an existing issue.
+{{end}}
 Return ONLY the corrected file content with no explanation or markdown code blocks.`,
-		Variables: []string{"filename", "content"},
+		Variables: []string{"filename", "content", "valid_states"},
 	},
 	"generate-issue": {
 		Name:        "generate-issue",
@@ -148,6 +152,41 @@ Generate a complete issue with:
 Return ONLY the complete markdown file content starting with --- frontmatter.`,
 		Variables: []string{"description", "type"},
 	},
+	"draft-issue-body": {
+		Name:        "draft-issue-body",
+		Description: "Draft a structured issue body from a title",
+		System: `You are a technical writer helping to create well-structured issue files.
+Write concise, actionable markdown in the same language as the title.`,
+		User: `Draft the body of an issue titled:
+{{.title}}
+
+Use these sections:
+## Summary
+## Steps
+## Acceptance Criteria
+
+Return ONLY the markdown body with no frontmatter, title heading, explanation or code fences.`,
+		Variables: []string{"title"},
+	},
+	"confirm-duplicate": {
+		Name:        "confirm-duplicate",
+		Description: "Confirm whether two issues describe the same work",
+		System:      `You are an issue triage assistant. Decide whether two issues describe the same problem or task.`,
+		User: `Are these two issues duplicates of each other?
+
+ISSUE #{{.number_a}}:
+{{.content_a}}
+
+ISSUE #{{.number_b}}:
+{{.content_b}}
+
+RESPOND WITH EXACTLY ONE OF:
+- "DUPLICATE: <brief explanation>" if they describe the same problem or task
+- "DISTINCT: <brief explanation>" if they are related but separate, or unrelated
+
+Keep your response to one sentence.`,
+		Variables: []string{"number_a", "content_a", "number_b", "content_b"},
+	},
 	"summarize-issue": {
 		Name:        "summarize-issue",
 		Description: "Summarize a long issue into key points",
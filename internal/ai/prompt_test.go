@@ -54,6 +54,8 @@ func TestBuiltinTemplates(t *testing.T) {
 		"repair-frontmatter",
 		"generate-issue",
 		"summarize-issue",
+		"draft-issue-body",
+		"confirm-duplicate",
 	}
 
 	for _, name := range expectedTemplates {
@@ -78,8 +80,9 @@ func TestRepairFrontmatterTemplate(t *testing.T) {
 	}
 
 	req, err := tmpl.Render(map[string]string{
-		"filename": "123-test-issue.md",
-		"content":  "some broken content",
+		"filename":     "123-test-issue.md",
+		"content":      "some broken content",
+		"valid_states": "open, wip, done, closed, review",
 	})
 
 	if err != nil {
@@ -93,4 +96,26 @@ func TestRepairFrontmatterTemplate(t *testing.T) {
 	if !strings.Contains(req.Prompt, "some broken content") {
 		t.Error("Prompt should contain content")
 	}
+
+	if !strings.Contains(req.Prompt, "state must be one of: open, wip, done, closed, review") {
+		t.Error("Prompt should list the valid states")
+	}
+
+	if strings.Contains(req.Prompt, "Other valid issues") {
+		t.Error("Prompt should not contain sibling context unless provided")
+	}
+
+	req, err = tmpl.Render(map[string]string{
+		"filename":     "123-test-issue.md",
+		"content":      "some broken content",
+		"all_issues":   "#122: Previous issue",
+		"valid_states": "open, wip, done, closed",
+	})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	if !strings.Contains(req.Prompt, "#122: Previous issue") {
+		t.Error("Prompt should contain sibling issues when provided")
+	}
 }
@@ -0,0 +1,70 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DefaultRetries is the default number of retries after a failed first
+// attempt of a completion request.
+const DefaultRetries = 2
+
+// defaultRetryDelay is the initial backoff delay, doubled on each retry.
+const defaultRetryDelay = 2 * time.Second
+
+// RetryClient wraps a Client and retries transient failures with exponential backoff.
+type RetryClient struct {
+	Client
+	attempts int
+	delay    time.Duration
+}
+
+// NewRetryClient wraps client so that a failed Complete is retried up to
+// retries times. If retries <= 0, the client is returned unchanged.
+func NewRetryClient(client Client, retries int) Client {
+	if client == nil || retries <= 0 {
+		return client
+	}
+	return &RetryClient{
+		Client:   client,
+		attempts: retries + 1,
+		delay:    defaultRetryDelay,
+	}
+}
+
+// Complete sends the request, retrying on retryable errors until the attempts
+// are exhausted or ctx is done.
+func (c *RetryClient) Complete(ctx context.Context, req *Request) (*Response, error) {
+	delay := c.delay
+
+	var lastErr error
+	for attempt := 1; attempt <= c.attempts; attempt++ {
+		resp, err := c.Client.Complete(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+
+		if !IsRetryable(err) || attempt == c.attempts || ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, lastErr
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+
+	return nil, lastErr
+}
+
+// IsRetryable reports whether err may succeed on a later attempt. Only rate
+// limits are; a missing CLI, bad flags or any other failure of the provider
+// would fail the same way again. A timeout is not retried either: providers
+// report it when ctx is done, which leaves no time for another attempt.
+func IsRetryable(err error) bool {
+	return errors.Is(err, ErrRateLimit)
+}
@@ -0,0 +1,85 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+// fakeClient fails with the queued errors before succeeding
+type fakeClient struct {
+	errs  []error
+	calls int
+}
+
+func (f *fakeClient) Name() string      { return "fake" }
+func (f *fakeClient) IsAvailable() bool { return true }
+
+func (f *fakeClient) Complete(ctx context.Context, req *Request) (*Response, error) {
+	f.calls++
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return nil, err
+	}
+	return &Response{Content: "ok"}, nil
+}
+
+func TestRetryClient(t *testing.T) {
+	tests := []struct {
+		name      string
+		errs      []error
+		retries   int
+		wantCalls int
+		wantErr   error
+	}{
+		{"succeeds first time", nil, 2, 1, nil},
+		{"retries rate limit", []error{ErrRateLimit, ErrRateLimit}, 2, 3, nil},
+		{"timeout is not retried", []error{ErrTimeout}, 2, 1, ErrTimeout},
+		{"gives up after retries", []error{ErrRateLimit, ErrRateLimit, ErrRateLimit}, 2, 3, ErrRateLimit},
+		{"auth failure is not retried", []error{ErrAuthFailed}, 2, 1, ErrAuthFailed},
+		{"provider failure is not retried", []error{ErrProviderFailed}, 2, 1, ErrProviderFailed},
+		{"missing binary is not retried", []error{exec.ErrNotFound}, 2, 1, exec.ErrNotFound},
+		{"caller deadline is not retried", []error{context.DeadlineExceeded}, 2, 1, context.DeadlineExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeClient{errs: tt.errs}
+			client := NewRetryClient(fake, tt.retries).(*RetryClient)
+			client.delay = time.Millisecond
+
+			_, err := client.Complete(context.Background(), &Request{Prompt: "hi"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if fake.calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", fake.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryClientHonorsCancellation(t *testing.T) {
+	fake := &fakeClient{errs: []error{ErrRateLimit, ErrRateLimit}}
+	client := NewRetryClient(fake, 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.Complete(ctx, &Request{}); !errors.Is(err, ErrRateLimit) {
+		t.Errorf("err = %v, want %v", err, ErrRateLimit)
+	}
+	if fake.calls != 1 {
+		t.Errorf("calls = %d, want 1", fake.calls)
+	}
+}
+
+func TestNewRetryClientNoRetries(t *testing.T) {
+	fake := &fakeClient{}
+	if client := NewRetryClient(fake, 0); client != fake {
+		t.Error("NewRetryClient with 0 retries should return the client unchanged")
+	}
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/itda-work/zap/internal/ai"
+	"github.com/spf13/cobra"
+)
+
+// aiCacheFile is the cached provider selection inside the issues directory.
+var aiCacheFile = filepath.Join(cacheDir, "ai")
+
+var aiCmd = &cobra.Command{
+	Use:   "ai",
+	Short: "Inspect AI provider selection",
+	Long: `Inspect which AI CLI zap uses for repair, report, fix-numbers and new --ai-body.
+
+The first auto-detected provider is cached per project in .issues/.cache/ai
+(ignored by git), so later runs use the same one even if other CLIs are installed. --ai always
+overrides the cached provider. If the cached provider is no longer available,
+zap detects again and updates the cache.`,
+}
+
+var aiStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show available and selected AI providers",
+	Long: `Show which AI CLIs are installed and which one zap will use for this project.
+
+Examples:
+  zap ai status            # Show providers and the selection
+  zap ai status --reset    # Forget the cached provider and detect again`,
+	Args: cobra.NoArgs,
+	RunE: runAIStatus,
+}
+
+var aiStatusReset bool
+
+func init() {
+	rootCmd.AddCommand(aiCmd)
+	aiCmd.AddCommand(aiStatusCmd)
+	aiStatusCmd.Flags().BoolVar(&aiStatusReset, "reset", false, "Clear the cached provider before detecting")
+}
+
+func runAIStatus(cmd *cobra.Command, args []string) error {
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := ai.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load AI config: %w", err)
+	}
+
+	if aiStatusReset {
+		if err := os.Remove(filepath.Join(dir, aiCacheFile)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to clear AI cache: %w", err)
+		}
+		fmt.Println("Cleared cached AI provider.")
+	}
+
+	fmt.Println("Providers:")
+	for _, provider := range ai.AllProviders() {
+		client := ai.NewClient(provider, cfg)
+		if client != nil && client.IsAvailable() {
+			fmt.Printf("  %s %s\n", glyph("✅", "+"), provider)
+		} else {
+			fmt.Printf("  %s %s %s\n", glyph("❌", "-"), provider, colorize("(not installed)", colorGray))
+		}
+	}
+	fmt.Println()
+
+	cached := loadCachedProvider(dir)
+	client, err := selectAIClient(dir, cfg)
+	if err != nil {
+		fmt.Println("Selected: none (install one of: claude, codex, gemini)")
+		return nil
+	}
+
+	source := "auto-detected, now cached"
+	if cached == client.Name() {
+		source = "cached in " + filepath.Join(dir, aiCacheFile)
+	}
+	fmt.Printf("Selected: %s %s\n", client.Name(), colorize("("+source+")", colorGray))
+	return nil
+}
+
+// selectAIClient returns the cached provider for the issues directory if it is
+// still available, otherwise auto-detects one and caches the choice.
+// An empty dir disables caching.
+func selectAIClient(dir string, cfg *ai.Config) (ai.Client, error) {
+	if name := loadCachedProvider(dir); name != "" {
+		if provider, ok := ai.ParseProvider(name); ok {
+			client := ai.NewClient(provider, cfg)
+			if client != nil && client.IsAvailable() {
+				return client, nil
+			}
+		}
+	}
+
+	client, err := ai.AutoDetect(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveCachedProvider(dir, client.Name()); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Failed to cache AI provider: %v\n", err)
+	}
+	return client, nil
+}
+
+// loadCachedProvider returns the cached provider name, or "" if none.
+func loadCachedProvider(dir string) string {
+	if dir == "" {
+		return ""
+	}
+	data, err := os.ReadFile(filepath.Join(dir, aiCacheFile))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// saveCachedProvider records the provider for the issues directory.
+// Nothing is written if the issues directory does not exist.
+func saveCachedProvider(dir, name string) error {
+	if dir == "" {
+		return nil
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil
+	}
+	return writeCacheFile(dir, filepath.Base(aiCacheFile), []byte(name+"\n"))
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itda-work/zap/internal/ai"
+)
+
+func TestSelectAIClientCache(t *testing.T) {
+	dir := t.TempDir()
+	cfg := ai.DefaultConfig()
+	cfg.Claude.Bin = "zap-test-missing-cli"
+	cfg.Codex.Bin = "sh"
+	cfg.Gemini.Bin = "sh"
+
+	steps := []struct {
+		name   string
+		cached string // written before selecting ("" = leave as is)
+		want   string
+	}{
+		{"auto-detect and cache", "", "codex"},
+		{"cached provider wins", "gemini", "gemini"},
+		{"unavailable cache re-detects", "claude", "codex"},
+	}
+
+	for _, step := range steps {
+		if step.cached != "" {
+			if err := saveCachedProvider(dir, step.cached); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		client, err := selectAIClient(dir, cfg)
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if client.Name() != step.want {
+			t.Errorf("%s: selected %s, want %s", step.name, client.Name(), step.want)
+		}
+		if got := loadCachedProvider(dir); got != step.want {
+			t.Errorf("%s: cached %q, want %q", step.name, got, step.want)
+		}
+	}
+}
+
+func TestSaveCachedProviderMissingDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	if err := saveCachedProvider(dir, "claude"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("issues directory was created: %v", err)
+	}
+}
+
+func TestSaveCachedProviderGitignore(t *testing.T) {
+	dir := t.TempDir()
+	if err := saveCachedProvider(dir, "claude"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, ".cache", ".gitignore"))
+	if err != nil {
+		t.Fatalf("cache directory has no .gitignore: %v", err)
+	}
+	if string(data) != "*\n" {
+		t.Errorf(".gitignore = %q, want %q", data, "*\n")
+	}
+}
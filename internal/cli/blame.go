@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+var blameCmd = &cobra.Command{
+	Use:   "blame <number>",
+	Short: "Show who last changed each issue field",
+	Long: `Show, for each frontmatter field of an issue, the commit and author that
+last changed it. The issue file is compared across its git history, following
+renames. Fields changed in the working tree are shown as uncommitted.
+
+Examples:
+  zap blame 12               # Who marked #12 done?`,
+	Args:              issueArgs(1),
+	ValidArgsFunction: completeIssueNumber,
+	RunE:              runBlame,
+}
+
+func init() {
+	rootCmd.AddCommand(blameCmd)
+}
+
+// blameFields are the frontmatter fields blame reports, in display order.
+var blameFields = []struct {
+	name  string
+	value func(*issue.Issue) string
+}{
+	{"state", func(i *issue.Issue) string { return string(i.State) }},
+	{"title", func(i *issue.Issue) string { return i.Title }},
+	{"labels", func(i *issue.Issue) string { return strings.Join(i.Labels, ", ") }},
+	{"assignees", func(i *issue.Issue) string { return strings.Join(i.Assignees, ", ") }},
+	{"points", func(i *issue.Issue) string {
+		if i.Points == 0 {
+			return ""
+		}
+		return strconv.Itoa(i.Points)
+	}},
+}
+
+// fieldBlame is the last change to one field. Commit is empty when the
+// change is not committed yet.
+type fieldBlame struct {
+	Field  string
+	Value  string
+	Commit string
+	Author string
+	At     time.Time
+}
+
+func runBlame(cmd *cobra.Command, args []string) error {
+	args, err := pickIssueArg(cmd, args, 1)
+	if err != nil {
+		return err
+	}
+	number, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid issue number: %s", args[0])
+	}
+
+	store, err := getStore(cmd)
+	if err != nil {
+		return err
+	}
+	iss, err := store.Get(number)
+	if err != nil {
+		return err
+	}
+
+	// A file that was never committed blames everything on the working tree
+	versions, _ := gitIssueVersions(iss.FilePath)
+
+	fmt.Printf("#%d %s\n", iss.Number, iss.Title)
+	for _, b := range blameIssue(versions, iss) {
+		value := b.Value
+		if value == "" {
+			value = "-"
+		}
+		origin := colorize("uncommitted", colorYellow)
+		if b.Commit != "" {
+			origin = fmt.Sprintf("%s %s %s", colorize(b.Commit, colorGray), b.Author,
+				colorize(b.At.Local().Format("2006-01-02 15:04"), colorGray))
+		}
+		fmt.Println(truncateLine(fmt.Sprintf("  %-10s %-24s %s", b.Field, value, origin), getTerminalWidth()))
+	}
+	return nil
+}
+
+// blameIssue finds the version that last changed each field. versions are
+// oldest first; current is the working tree copy of the issue.
+func blameIssue(versions []issueVersion, current *issue.Issue) []fieldBlame {
+	blames := make([]fieldBlame, len(blameFields))
+	var prev *issue.Issue
+	for _, v := range versions {
+		for i, f := range blameFields {
+			if prev == nil || f.value(prev) != f.value(v.Issue) {
+				blames[i] = fieldBlame{Commit: v.Commit, Author: v.Author, At: v.At}
+			}
+		}
+		prev = v.Issue
+	}
+
+	for i, f := range blameFields {
+		if prev == nil || f.value(prev) != f.value(current) {
+			blames[i] = fieldBlame{}
+		}
+		blames[i].Field = f.name
+		blames[i].Value = f.value(current)
+	}
+	return blames
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+func TestBlameIssue(t *testing.T) {
+	at := func(day int) time.Time { return time.Date(2026, 1, day, 9, 0, 0, 0, time.UTC) }
+	v1 := &issue.Issue{Number: 1, Title: "Login", State: issue.StateOpen}
+	v2 := &issue.Issue{Number: 1, Title: "Login crashes", State: issue.StateOpen}
+	v3 := &issue.Issue{Number: 1, Title: "Login crashes", State: issue.StateDone}
+	versions := []issueVersion{
+		{Commit: "aaa1111", Author: "alice", At: at(1), Issue: v1},
+		{Commit: "bbb2222", Author: "bob", At: at(2), Issue: v2},
+		{Commit: "ccc3333", Author: "carol", At: at(3), Issue: v3},
+	}
+	current := &issue.Issue{Number: 1, Title: "Login crashes", State: issue.StateDone, Labels: []string{"bug", "ui"}}
+
+	want := map[string]struct{ value, commit string }{
+		"state":     {"done", "ccc3333"},
+		"title":     {"Login crashes", "bbb2222"},
+		"labels":    {"bug, ui", ""}, // Uncommitted
+		"assignees": {"", "aaa1111"},
+	}
+	for _, b := range blameIssue(versions, current) {
+		w, ok := want[b.Field]
+		if !ok {
+			continue
+		}
+		if b.Value != w.value || b.Commit != w.commit {
+			t.Errorf("%s = %q by %q, want %q by %q", b.Field, b.Value, b.Commit, w.value, w.commit)
+		}
+	}
+
+	for _, b := range blameIssue(nil, current) {
+		if b.Commit != "" {
+			t.Errorf("%s blamed on %s without git history", b.Field, b.Commit)
+		}
+	}
+}
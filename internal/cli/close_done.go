@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+// runCloseAllDone closes every done issue for 'zap set --all-done-to-closed'.
+func runCloseAllDone(cmd *cobra.Command) error {
+	if isMultiProjectMode(cmd) {
+		return fmt.Errorf("--all-done-to-closed is not supported in multi-project mode")
+	}
+	if setPorcelain && setDryRun {
+		return fmt.Errorf("--porcelain cannot be used with --dry-run")
+	}
+	if setPorcelain && !setYes {
+		return fmt.Errorf("--porcelain requires --yes with --all-done-to-closed")
+	}
+
+	var olderThan time.Duration
+	if setOlderThan != "" {
+		var err error
+		if olderThan, err = parseRecurInterval(setOlderThan); err != nil {
+			return fmt.Errorf("invalid --older-than: %w", err)
+		}
+	}
+
+	dir, store, err := openSetStore(cmd)
+	if err != nil {
+		return err
+	}
+
+	done, err := store.List(issue.StateDone)
+	if err != nil {
+		return fmt.Errorf("failed to list issues: %w", err)
+	}
+	issues := doneLongerThan(done, olderThan, time.Now())
+	if len(issues) == 0 {
+		if !setPorcelain {
+			fmt.Println("No done issues to close.")
+		}
+		return nil
+	}
+
+	if !setPorcelain {
+		for _, iss := range issues {
+			fmt.Printf("  #%d %s %s\n", iss.Number, iss.Title, colorize("(done "+doneSince(iss).Local().Format("2006-01-02")+")", colorGray))
+		}
+	}
+	if setDryRun {
+		fmt.Printf("Would close %d issue(s).\n", len(issues))
+		return nil
+	}
+	if !setYes {
+		fmt.Println()
+		if !confirm(fmt.Sprintf("Close %d done issue(s)?", len(issues))) {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	undo := newUndoRecorder(dir, "set --all-done-to-closed")
+	closed := 0
+	for _, iss := range issues {
+		if err := transitionIssue(store, undo, iss, issue.StateClosed); err != nil {
+			undo.saveOrWarn()
+			return fmt.Errorf("#%d: %w", iss.Number, err)
+		}
+		closed++
+		if setPorcelain {
+			printPorcelain(iss.Number, issue.StateDone, issue.StateClosed, movedPath(store, iss))
+		}
+	}
+	undo.saveOrWarn()
+
+	if !setPorcelain {
+		fmt.Printf("Closed %d issue(s).\n", closed)
+	}
+	return nil
+}
+
+// doneLongerThan returns the issues that have been done for at least d as
+// of now. A zero d selects all of them.
+func doneLongerThan(issues []*issue.Issue, d time.Duration, now time.Time) []*issue.Issue {
+	var result []*issue.Issue
+	for _, iss := range issues {
+		if d == 0 || !doneSince(iss).After(now.Add(-d)) {
+			result = append(result, iss)
+		}
+	}
+	return result
+}
+
+// doneSince returns when an issue was marked done: closed_at, or updated_at
+// for files without it.
+func doneSince(iss *issue.Issue) time.Time {
+	if iss.ClosedAt != nil {
+		return *iss.ClosedAt
+	}
+	return iss.UpdatedAt
+}
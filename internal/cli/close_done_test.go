@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+func TestDoneLongerThan(t *testing.T) {
+	now := time.Date(2026, 5, 20, 12, 0, 0, 0, time.UTC)
+	closedAt := now.Add(-10 * 24 * time.Hour)
+	issues := []*issue.Issue{
+		{Number: 1, ClosedAt: &closedAt, UpdatedAt: now},
+		{Number: 2, UpdatedAt: now.Add(-3 * 24 * time.Hour)}, // No closed_at
+		{Number: 3, UpdatedAt: now.Add(-time.Hour)},
+	}
+
+	tests := []struct {
+		name string
+		d    time.Duration
+		want []int
+	}{
+		{"all", 0, []int{1, 2, 3}},
+		{"two days", 48 * time.Hour, []int{1, 2}},
+		{"exactly ten days", 10 * 24 * time.Hour, []int{1}},
+		{"a month", 30 * 24 * time.Hour, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, iss := range doneLongerThan(issues, tt.d, now) {
+				got = append(got, iss.Number)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("doneLongerThan() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("doneLongerThan() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRunCloseAllDone(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now().UTC()
+	files := []struct {
+		name     string
+		state    string
+		closedAt time.Time
+	}{
+		{"001-old.md", "done", now.Add(-10 * 24 * time.Hour)},
+		{"002-recent.md", "done", now.Add(-24 * time.Hour)},
+		{"003-open.md", "open", time.Time{}},
+	}
+	for i, f := range files {
+		content := fmt.Sprintf("---\nnumber: %d\ntitle: Issue\nstate: %s\ncreated_at: 2026-01-01T00:00:00Z\nupdated_at: 2026-01-01T00:00:00Z\n", i+1, f.state)
+		if !f.closedAt.IsZero() {
+			content += "closed_at: " + f.closedAt.Format(time.RFC3339) + "\n"
+		}
+		if err := os.WriteFile(filepath.Join(dir, f.name), []byte(content+"---\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	setYes, setOlderThan = true, "5d"
+	t.Cleanup(func() { setYes, setOlderThan = false, "" })
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringArray("project", nil, "")
+	cmd.Flags().String("dir", ".issues", "")
+	if err := cmd.Flags().Set("dir", dir); err != nil {
+		t.Fatal(err)
+	}
+	captureStdout(t, func() error { return runCloseAllDone(cmd) })
+
+	store := issue.NewStore(dir)
+	for number, want := range map[int]issue.State{1: issue.StateClosed, 2: issue.StateDone, 3: issue.StateOpen} {
+		iss, err := store.Get(number)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if iss.State != want {
+			t.Errorf("#%d state = %s, want %s", number, iss.State, want)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, undoFileName))
+	if err != nil {
+		t.Fatalf("undo log not written: %v", err)
+	}
+	var record undoRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		t.Fatal(err)
+	}
+	if len(record.Files) != 1 || record.Files[0].Path != "001-old.md" {
+		t.Errorf("undo log files = %+v, want only 001-old.md", record.Files)
+	}
+}
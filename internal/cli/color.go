@@ -1,11 +1,14 @@
 package cli
 
 import (
+	"fmt"
+	"hash/fnv"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/itda-work/zap/internal/issue"
 	"github.com/mattn/go-isatty"
 	"golang.org/x/term"
 )
@@ -79,6 +82,10 @@ var (
 // colorEnabled indicates whether color output is supported
 var colorEnabled bool
 
+// plainOutput is set by the global --plain flag.
+// Plain output is ASCII-only: no ANSI colors, box-drawing characters or emoji.
+var plainOutput bool
+
 // currentTheme holds the detected or configured theme
 var currentTheme Theme
 
@@ -311,3 +318,111 @@ func colorizeWithBg(text, fgColor, bgColor string) string {
 	}
 	return bgColor + fgColor + text + colorReset
 }
+
+// hyperlink makes text a clickable link to url in terminals that support
+// OSC 8 hyperlinks; without colors the text is returned unchanged.
+func hyperlink(text, url string) string {
+	if !colorEnabled || url == "" {
+		return text
+	}
+	return "\033]8;;" + url + "\033\\" + text + "\033]8;;\033\\"
+}
+
+// glyph returns fancy normally, or its ASCII replacement in --plain mode
+func glyph(fancy, plain string) string {
+	if plainOutput {
+		return plain
+	}
+	return fancy
+}
+
+// hrule returns a 60-column horizontal rule drawn with ch ('-' in --plain mode)
+func hrule(ch string) string {
+	return strings.Repeat(glyph(ch, "-"), 60)
+}
+
+// labelColors maps label names to configured colors (from .zap.yml)
+var labelColors map[string]string
+
+// labelColor returns the ANSI color for a label: the configured color if any,
+// otherwise one picked from a fixed palette by a hash of the name so the same
+// label always gets the same color
+func labelColor(label string) string {
+	if name, ok := labelColors[label]; ok {
+		if code := namedColor(name); code != "" {
+			return code
+		}
+	}
+
+	palette := []string{colorCyan, colorBlue, colorMagenta, colorGreen, colorYellow, colorBrightMagenta}
+	h := fnv.New32a()
+	h.Write([]byte(label))
+	return palette[h.Sum32()%uint32(len(palette))]
+}
+
+// stateLook is how a state is shown in issue lists.
+type stateLook struct {
+	tag        string
+	color      string
+	titleColor string
+}
+
+// stateStyle returns the list tag and colors of a state. Built-in states
+// keep their look unless .zap.yml gives them a color.
+func stateStyle(s issue.State) stateLook {
+	def, ok := issue.LookupState(s)
+	if !ok {
+		return stateLook{tag: "[" + string(s) + "]"}
+	}
+	look := stateLook{tag: def.Label()}
+	if def.Color != "" {
+		look.color = namedColor(def.Color)
+		look.titleColor = look.color
+		return look
+	}
+	switch s {
+	case issue.StateWip:
+		look.color, look.titleColor = colorBrightYellow, colorBrightYellow
+	case issue.StateDone:
+		look.color, look.titleColor = colorBrightGreen, colorBrightGreen
+	case issue.StateClosed:
+		look.color, look.titleColor = colorGray, colorLightGray
+	}
+	return look
+}
+
+// namedColor converts a config color name or 256-color number to an ANSI code
+func namedColor(name string) string {
+	switch name {
+	case "red":
+		return colorRed
+	case "green":
+		return colorGreen
+	case "yellow":
+		return colorYellow
+	case "blue":
+		return colorBlue
+	case "magenta":
+		return colorMagenta
+	case "cyan":
+		return colorCyan
+	case "gray":
+		return colorGray
+	}
+	if n, err := strconv.Atoi(name); err == nil && n >= 0 && n <= 255 {
+		return "\033[38;5;" + name + "m"
+	}
+	return ""
+}
+
+// formatLabels renders labels as " [a, b]" with each label colorized
+func formatLabels(labels []string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	chips := make([]string, len(labels))
+	for i, l := range labels {
+		chips[i] = colorize(l, labelColor(l))
+	}
+	return fmt.Sprintf(" [%s]", strings.Join(chips, ", "))
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/itda-work/zap/internal/issue"
@@ -81,3 +82,62 @@ func completeIssueNumberExcluding(excludeStates ...issue.State) func(*cobra.Comm
 		return completions, cobra.ShellCompDirectiveNoFileComp
 	}
 }
+
+// completionStats caches issue stats per issues directory so that completing
+// several flags in one invocation scans the store only once.
+var completionStats = make(map[string]*issue.Stats)
+
+// loadCompletionStats returns the (cached) stats for the current issues directory.
+func loadCompletionStats(cmd *cobra.Command) (*issue.Stats, error) {
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if stats, ok := completionStats[dir]; ok {
+		return stats, nil
+	}
+
+	stats, err := issue.NewStore(dir).Stats()
+	if err != nil {
+		return nil, err
+	}
+
+	completionStats[dir] = stats
+	return stats, nil
+}
+
+// completeLabels provides shell completion for --label flags.
+func completeLabels(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	stats, err := loadCompletionStats(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return completeCounts(stats.ByLabel, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeAssignees provides shell completion for --assignee flags.
+func completeAssignees(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	stats, err := loadCompletionStats(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return completeCounts(stats.ByAssignee, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeCounts returns sorted "name\tN issues" completions matching the prefix.
+func completeCounts(counts map[string]int, toComplete string) []string {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		if strings.HasPrefix(name, toComplete) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	completions := make([]string, len(names))
+	for i, name := range names {
+		completions[i] = fmt.Sprintf("%s\t%d issues", name, counts[name])
+	}
+	return completions
+}
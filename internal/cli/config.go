@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/itda-work/zap/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Get and set project config values",
+	Long: `Read and write the project config file (.zap.yml) next to the issues directory.
+
+Keys are dotted paths into the YAML, e.g. "lang" or "labels.bug".
+Values are parsed as YAML, so lists can be written as "[wip, done]".
+Comments and key order in the file are kept when writing.
+
+Unknown keys print a warning; use --strict to make them an error.
+
+Examples:
+  zap config list                                   # Show all values
+  zap config get lang                               # Print one value
+  zap config set lang en                            # Set a value
+  zap config set labels.bug red                     # Set a nested value
+  zap config set workflow.transitions.open "[wip]"  # Set a list`,
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print a config value",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConfigGet,
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a config value",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runConfigSet,
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all config values",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigList,
+}
+
+var configStrict bool
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configListCmd)
+
+	configCmd.PersistentFlags().BoolVar(&configStrict, "strict", false, "Treat unknown keys as errors")
+}
+
+func runConfigGet(cmd *cobra.Command, args []string) error {
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+	if err := checkConfigKey(args[0]); err != nil {
+		return err
+	}
+
+	value, err := config.Get(dir, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Println(value)
+	return nil
+}
+
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+	if err := checkConfigKey(args[0]); err != nil {
+		return err
+	}
+
+	if err := config.Set(dir, args[0], args[1]); err != nil {
+		return err
+	}
+	fmt.Printf("%s %s = %s %s\n", glyph("✅", "OK"), args[0], args[1], colorize("("+config.Path(dir)+")", colorGray))
+	return nil
+}
+
+func runConfigList(cmd *cobra.Command, args []string) error {
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	values, err := config.List(dir)
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		fmt.Printf("No config values set (%s).\n", config.Path(dir))
+		return nil
+	}
+
+	for _, v := range values {
+		if err := checkConfigKey(v.Key); err != nil {
+			return err
+		}
+		fmt.Printf("%s=%s\n", v.Key, v.Value)
+	}
+	return nil
+}
+
+// checkConfigKey warns about keys zap doesn't use, or rejects them with --strict.
+func checkConfigKey(key string) error {
+	if config.IsKnownKey(key) {
+		return nil
+	}
+	if configStrict {
+		return fmt.Errorf("unknown config key %q (known: %s)", key, strings.Join(config.KnownKeys(), ", "))
+	}
+	fmt.Fprintf(os.Stderr, "%s unknown config key %q\n", glyph("⚠️ ", "warning:"), key)
+	return nil
+}
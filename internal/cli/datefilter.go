@@ -35,7 +35,7 @@ func (f *DateFilter) GetDateRange() (start, end time.Time, err error) {
 
 	if f.Today {
 		start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
-		end = start.Add(24 * time.Hour)
+		end = start.AddDate(0, 0, 1)
 		return
 	}
 
@@ -57,7 +57,7 @@ func (f *DateFilter) GetDateRange() (start, end time.Time, err error) {
 			return start, end, fmt.Errorf("invalid date format: %s (expected YYYY-MM-DD)", f.Date)
 		}
 		start = t
-		end = t.Add(24 * time.Hour)
+		end = t.AddDate(0, 0, 1)
 		return
 	}
 
@@ -94,8 +94,9 @@ func (f *DateFilter) GetDateRange() (start, end time.Time, err error) {
 		if parseErr != nil {
 			return start, end, fmt.Errorf("invalid until date format: %s (expected YYYY-MM-DD)", f.Until)
 		}
-		// Until is inclusive, so add 1 day
-		end = t.Add(24 * time.Hour)
+		// Until is inclusive, so end at the next local midnight.
+		// AddDate rather than 24h keeps this right across DST changes.
+		end = t.AddDate(0, 0, 1)
 	}
 
 	return
@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/itda-work/zap/internal/ai"
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+var dedupeCmd = &cobra.Command{
+	Use:   "dedupe",
+	Short: "Report likely duplicate issues",
+	Long: `Compare issue titles and bodies and report groups of likely duplicates.
+
+Similarity is the overlap of character trigrams (Jaccard index) over the
+normalized title and body, weighted towards the title. Pairs at or above
+--threshold are reported; pairs sharing an issue are grouped together.
+
+With --ai-confirm, each pair is checked by an AI CLI and pairs it judges
+distinct are dropped. Nothing is changed; close duplicates with 'zap set closed'.
+
+Examples:
+  zap dedupe                       # Open and wip issues
+  zap dedupe --all                 # Include done and closed issues
+  zap dedupe --threshold 0.4       # Report looser matches
+  zap dedupe --ai-confirm          # Confirm pairs with AI`,
+	Args: cobra.NoArgs,
+	RunE: runDedupe,
+}
+
+var (
+	dedupeThreshold float64
+	dedupeAll       bool
+	dedupeAIConfirm bool
+	dedupeAI        string
+	dedupeAIModel   string
+)
+
+// dedupeExcerptLimit caps the issue body sent to AI for each side of a pair.
+const dedupeExcerptLimit = 1500
+
+func init() {
+	rootCmd.AddCommand(dedupeCmd)
+
+	dedupeCmd.Flags().Float64Var(&dedupeThreshold, "threshold", 0.6, "Minimum similarity to report (0-1)")
+	dedupeCmd.Flags().BoolVarP(&dedupeAll, "all", "a", false, "Include done and closed issues")
+	dedupeCmd.Flags().BoolVar(&dedupeAIConfirm, "ai-confirm", false, "Confirm each pair with AI")
+	dedupeCmd.Flags().StringVar(&dedupeAI, "ai", "", "AI CLI to use (claude, codex, gemini)")
+	dedupeCmd.Flags().StringVar(&dedupeAIModel, "ai-model", "", "AI model to use (overrides ai.yaml)")
+}
+
+func runDedupe(cmd *cobra.Command, args []string) error {
+	if dedupeThreshold <= 0 || dedupeThreshold > 1 {
+		return fmt.Errorf("invalid threshold: %v (must be between 0 and 1)", dedupeThreshold)
+	}
+
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	states := issue.ActiveStates()
+	if dedupeAll {
+		states = issue.AllStates()
+	}
+	issues, err := issue.NewStore(dir).List(states...)
+	if err != nil {
+		return fmt.Errorf("failed to list issues: %w", err)
+	}
+
+	pairs := issue.FindDuplicatePairs(issues, dedupeThreshold)
+
+	if dedupeAIConfirm && len(pairs) > 0 {
+		pairs, err = confirmDuplicatePairs(dir, pairs)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(pairs) == 0 {
+		fmt.Printf("No likely duplicates among %d issues (threshold %.2f).\n", len(issues), dedupeThreshold)
+		return nil
+	}
+
+	groups := issue.GroupDuplicates(pairs)
+	fmt.Printf("Found %d group(s) of likely duplicates among %d issues (threshold %.2f)\n",
+		len(groups), len(issues), dedupeThreshold)
+
+	width := getTerminalWidth()
+	for i, group := range groups {
+		fmt.Println()
+		fmt.Println(colorize(fmt.Sprintf("Group %d", i+1), colorCyan))
+
+		inGroup := make(map[int]bool, len(group))
+		for _, iss := range group {
+			inGroup[iss.Number] = true
+			tag := colorize(fmt.Sprintf("%-8s", "["+string(iss.State)+"]"), stateColor(iss.State))
+			fmt.Println(truncateLine(fmt.Sprintf("  %s #%-4d %s%s", tag, iss.Number, iss.Title, formatLabels(iss.Labels)), width))
+		}
+		for _, p := range pairs {
+			if inGroup[p.A.Number] {
+				fmt.Println(colorize(fmt.Sprintf("    #%d %s #%d  %.0f%%", p.A.Number, glyph("↔", "<->"), p.B.Number, p.Score*100), colorGray))
+			}
+		}
+	}
+	return nil
+}
+
+// confirmDuplicatePairs asks AI about each pair and keeps the ones it
+// confirms. Pairs that can't be checked are kept with a warning.
+func confirmDuplicatePairs(dir string, pairs []issue.DuplicatePair) ([]issue.DuplicatePair, error) {
+	client, err := getAIClient(dir, dedupeAI, dedupeAIModel)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, ok := ai.GetTemplate("confirm-duplicate")
+	if !ok {
+		return nil, fmt.Errorf("confirm-duplicate template not found")
+	}
+
+	cfg, _ := ai.LoadConfig()
+	fmt.Fprintf(os.Stderr, "🤖 Confirming %d pair(s) with %s...\n", len(pairs), client.Name())
+
+	var confirmed []issue.DuplicatePair
+	for _, p := range pairs {
+		req, err := tmpl.Render(map[string]string{
+			"number_a":  strconv.Itoa(p.A.Number),
+			"content_a": dedupeExcerpt(p.A),
+			"number_b":  strconv.Itoa(p.B.Number),
+			"content_b": dedupeExcerpt(p.B),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to render prompt: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+		resp, err := client.Complete(ctx, req)
+		cancel()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  AI check of #%d and #%d failed: %v (keeping pair)\n", p.A.Number, p.B.Number, err)
+			confirmed = append(confirmed, p)
+			continue
+		}
+
+		answer := strings.TrimSpace(resp.Content)
+		if strings.HasPrefix(answer, "DISTINCT:") {
+			fmt.Fprintf(os.Stderr, "   #%d, #%d: %s\n", p.A.Number, p.B.Number, answer)
+			continue
+		}
+		confirmed = append(confirmed, p)
+	}
+	return confirmed, nil
+}
+
+// dedupeExcerpt is the issue text sent to AI for comparison.
+func dedupeExcerpt(iss *issue.Issue) string {
+	body := iss.Body
+	if runes := []rune(body); len(runes) > dedupeExcerptLimit {
+		body = string(runes[:dedupeExcerptLimit]) + "..."
+	}
+	return "Title: " + iss.Title + "\n\n" + body
+}
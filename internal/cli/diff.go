@@ -0,0 +1,189 @@
+package cli
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <from-ref> [to-ref]",
+	Short: "Show issues changed between two git revisions",
+	Long: `Show which issue files changed between two git revisions and summarize
+the changes (state, title, labels, assignees, body).
+
+If to-ref is omitted, HEAD is used. A single "from..to" argument is also accepted.
+
+Examples:
+  zap diff v0.6.6              # v0.6.6 to HEAD
+  zap diff v0.6.5 v0.6.6       # v0.6.5 to v0.6.6
+  zap diff v0.6.5..v0.6.6`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runDiff,
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+}
+
+// issueDelta describes how a single issue file changed between revisions
+type issueDelta struct {
+	Status byte // 'A' added, 'D' deleted, 'M' modified
+	Old    *issue.Issue
+	New    *issue.Issue
+	Path   string
+}
+
+// number returns the issue number from whichever side is available
+func (d *issueDelta) number() int {
+	if d.New != nil {
+		return d.New.Number
+	}
+	if d.Old != nil {
+		return d.Old.Number
+	}
+	return 0
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	fromRef, toRef := parseDiffRefs(args)
+
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	deltas, err := collectIssueDeltas(dir, fromRef, toRef)
+	if err != nil {
+		return err
+	}
+
+	printIssueDeltas(deltas, fromRef, toRef)
+	return nil
+}
+
+// parseDiffRefs resolves the from/to refs from command arguments
+func parseDiffRefs(args []string) (string, string) {
+	if len(args) == 2 {
+		return args[0], args[1]
+	}
+	if from, to, ok := strings.Cut(args[0], ".."); ok {
+		if to == "" {
+			to = "HEAD"
+		}
+		return from, to
+	}
+	return args[0], "HEAD"
+}
+
+// collectIssueDeltas parses the before/after versions of every issue file
+// changed between fromRef and toRef. git runs in the repository of dir, so
+// this works when dir is in another project (-C).
+func collectIssueDeltas(dir, fromRef, toRef string) ([]*issueDelta, error) {
+	root := issue.GitRoot(dir)
+	if root == "" {
+		return nil, fmt.Errorf("%s is not in a git repository", dir)
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	// git reports the root with symlinks resolved; the pathspec must match
+	if resolved, err := filepath.EvalSymlinks(absDir); err == nil {
+		absDir = resolved
+	}
+
+	changes, err := getNameStatus(root, fromRef, toRef, "--", absDir)
+	if err != nil {
+		return nil, fmt.Errorf("git diff failed: %w", err)
+	}
+
+	var deltas []*issueDelta
+	for _, c := range changes {
+		if !strings.HasSuffix(c.Path, ".md") {
+			continue
+		}
+
+		delta := &issueDelta{Status: c.Status, Path: c.Path}
+		switch c.Status {
+		case 'A':
+			delta.New = parseIssueAtRef(root, toRef, c.Path)
+		case 'D':
+			delta.Old = parseIssueAtRef(root, fromRef, c.Path)
+		default:
+			delta.Status = 'M'
+			delta.Old = parseIssueAtRef(root, fromRef, c.OldPath)
+			delta.New = parseIssueAtRef(root, toRef, c.Path)
+		}
+		deltas = append(deltas, delta)
+	}
+
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].number() < deltas[j].number()
+	})
+
+	return deltas, nil
+}
+
+// parseIssueAtRef parses an issue file as it existed at the given git ref.
+// path is relative to the root of the repository git runs in, which is
+// dir or, if dir is empty, the current directory.
+// Returns nil if the file can't be read or parsed.
+func parseIssueAtRef(dir, ref, path string) *issue.Issue {
+	cmd := exec.Command("git", "show", fmt.Sprintf("%s:%s", ref, filepath.ToSlash(path)))
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+	iss, err := issue.ParseBytes(output, path)
+	if err != nil {
+		return nil
+	}
+	return iss
+}
+
+func printIssueDeltas(deltas []*issueDelta, fromRef, toRef string) {
+	if len(deltas) == 0 {
+		fmt.Printf("No issue changes between %s and %s.\n", fromRef, toRef)
+		return
+	}
+
+	fmt.Printf("Issue changes %s..%s (%d files)\n\n", fromRef, toRef, len(deltas))
+
+	for _, d := range deltas {
+		switch d.Status {
+		case 'A':
+			if d.New == nil {
+				fmt.Printf("%s %s\n", colorize("+", colorGreen), filepath.Base(d.Path))
+				continue
+			}
+			fmt.Printf("%s #%-4d %s %s\n", colorize("+", colorGreen), d.New.Number, d.New.Title,
+				colorize(fmt.Sprintf("(added, %s)", d.New.State), colorGray))
+		case 'D':
+			if d.Old == nil {
+				fmt.Printf("%s %s\n", colorize("-", colorRed), filepath.Base(d.Path))
+				continue
+			}
+			fmt.Printf("%s #%-4d %s %s\n", colorize("-", colorRed), d.Old.Number, d.Old.Title,
+				colorize("(deleted)", colorGray))
+		default:
+			if d.Old == nil || d.New == nil {
+				fmt.Printf("%s %s %s\n", colorize("~", colorYellow), filepath.Base(d.Path),
+					colorize("(unparsable)", colorGray))
+				continue
+			}
+			summary := generateChangeSummary(d.Old, d.New)
+			if summary == "" {
+				summary = "metadata updated"
+			}
+			fmt.Printf("%s #%-4d %s %s\n", colorize("~", colorYellow), d.New.Number, d.New.Title,
+				colorize("("+summary+")", colorGray))
+		}
+	}
+}
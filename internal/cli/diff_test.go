@@ -0,0 +1,23 @@
+package cli
+
+import "testing"
+
+func TestParseDiffRefs(t *testing.T) {
+	tests := []struct {
+		args     []string
+		wantFrom string
+		wantTo   string
+	}{
+		{[]string{"v1.0"}, "v1.0", "HEAD"},
+		{[]string{"v1.0", "v1.1"}, "v1.0", "v1.1"},
+		{[]string{"v1.0..v1.1"}, "v1.0", "v1.1"},
+		{[]string{"v1.0.."}, "v1.0", "HEAD"},
+	}
+
+	for _, tt := range tests {
+		from, to := parseDiffRefs(tt.args)
+		if from != tt.wantFrom || to != tt.wantTo {
+			t.Errorf("parseDiffRefs(%v) = (%q, %q), want (%q, %q)", tt.args, from, to, tt.wantFrom, tt.wantTo)
+		}
+	}
+}
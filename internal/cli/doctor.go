@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/itda-work/zap/internal/ai"
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose the issues directory and environment",
+	Long: `Run a series of health checks and print suggested fixes.
+
+Checks:
+  - issues directory exists
+  - legacy directory structure (needs 'zap migrate')
+  - files that fail to parse (needs 'zap repair')
+  - issue number conflicts (needs 'zap fix-numbers')
+  - AI CLI availability (claude, codex, gemini)
+  - git availability`,
+	Args: cobra.NoArgs,
+	RunE: runDoctor,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
+
+// doctorStatus is the outcome of a single doctor check
+type doctorStatus int
+
+const (
+	doctorOK doctorStatus = iota
+	doctorWarn
+	doctorFail
+)
+
+// doctorCheck is a single line of the doctor checklist
+type doctorCheck struct {
+	status  doctorStatus
+	message string
+	fix     string // Suggested fix (empty if none)
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	checks := collectDoctorChecks(dir)
+	printDoctorChecks(checks)
+
+	for _, c := range checks {
+		if c.status == doctorFail {
+			return fmt.Errorf("doctor found problems")
+		}
+	}
+	return nil
+}
+
+// collectDoctorChecks runs all checks against the issues directory
+func collectDoctorChecks(dir string) []doctorCheck {
+	var checks []doctorCheck
+
+	stat, err := os.Stat(dir)
+	if err != nil || !stat.IsDir() {
+		checks = append(checks, doctorCheck{
+			status:  doctorFail,
+			message: fmt.Sprintf("Issues directory not found: %s", dir),
+			fix:     "Run 'zap new <title>' to create the first issue, or pass -d/-C",
+		})
+	} else {
+		checks = append(checks, doctorCheck{
+			status:  doctorOK,
+			message: fmt.Sprintf("Issues directory: %s", dir),
+		})
+		checks = append(checks, checkIssuesDir(dir)...)
+	}
+
+	checks = append(checks, checkAIProvider(), checkGit())
+	return checks
+}
+
+// checkIssuesDir checks structure, parse failures and number conflicts
+func checkIssuesDir(dir string) []doctorCheck {
+	var checks []doctorCheck
+	store := issue.NewStore(dir)
+
+	info, err := store.DetectLegacyStructure()
+	switch {
+	case err != nil:
+		checks = append(checks, doctorCheck{status: doctorFail, message: fmt.Sprintf("Failed to inspect structure: %v", err)})
+	case info.HasLegacyStructure:
+		checks = append(checks, doctorCheck{
+			status:  doctorWarn,
+			message: fmt.Sprintf("Legacy directory structure detected (%d issues)", info.TotalIssues),
+			fix:     "Run 'zap migrate'",
+		})
+	default:
+		checks = append(checks, doctorCheck{status: doctorOK, message: "Flat directory structure"})
+	}
+
+	issues, err := store.List(issue.AllStates()...)
+	if err != nil {
+		checks = append(checks, doctorCheck{status: doctorFail, message: fmt.Sprintf("Failed to list issues: %v", err)})
+		return checks
+	}
+
+	if warnings := store.Warnings(); len(warnings) > 0 {
+		checks = append(checks, doctorCheck{
+			status:  doctorWarn,
+			message: fmt.Sprintf("%d of %d files failed to parse", len(warnings), len(issues)+len(warnings)),
+			fix:     "Run 'zap stats --failures' to list them, then 'zap repair'",
+		})
+	} else {
+		checks = append(checks, doctorCheck{status: doctorOK, message: fmt.Sprintf("%d issues parsed", len(issues))})
+	}
+
+	conflicts, err := issue.NewConflictDetector(dir).DetectConflicts()
+	switch {
+	case err != nil:
+		checks = append(checks, doctorCheck{status: doctorFail, message: fmt.Sprintf("Failed to detect conflicts: %v", err)})
+	case len(conflicts) > 0:
+		checks = append(checks, doctorCheck{
+			status:  doctorWarn,
+			message: fmt.Sprintf("%d issue number conflicts", len(conflicts)),
+			fix:     "Run 'zap fix-numbers'",
+		})
+	default:
+		checks = append(checks, doctorCheck{status: doctorOK, message: "No issue number conflicts"})
+	}
+
+	return checks
+}
+
+// checkAIProvider checks whether an AI CLI is available
+func checkAIProvider() doctorCheck {
+	cfg, err := ai.LoadConfig()
+	if err != nil {
+		return doctorCheck{
+			status:  doctorWarn,
+			message: fmt.Sprintf("Failed to load AI config: %v", err),
+			fix:     "Check ~/.config/zap/ai.yaml",
+		}
+	}
+
+	client, err := ai.AutoDetect(cfg)
+	if err != nil {
+		return doctorCheck{
+			status:  doctorWarn,
+			message: "No AI CLI available (AI features disabled)",
+			fix:     "Install one of: claude, codex, gemini",
+		}
+	}
+	return doctorCheck{status: doctorOK, message: fmt.Sprintf("AI provider: %s", client.Name())}
+}
+
+// checkGit checks whether git is installed
+func checkGit() doctorCheck {
+	if _, err := exec.LookPath("git"); err != nil {
+		return doctorCheck{
+			status:  doctorWarn,
+			message: "git not found (report, history and conflict detection are limited)",
+			fix:     "Install git",
+		}
+	}
+	return doctorCheck{status: doctorOK, message: "git available"}
+}
+
+func printDoctorChecks(checks []doctorCheck) {
+	icons := map[doctorStatus]string{
+		doctorOK:   "✅",
+		doctorWarn: "⚠️ ",
+		doctorFail: "❌",
+	}
+
+	for _, c := range checks {
+		fmt.Printf("%s %s\n", icons[c.status], c.message)
+		if c.fix != "" {
+			fmt.Printf("   %s\n", colorize("→ "+c.fix, colorGray))
+		}
+	}
+}
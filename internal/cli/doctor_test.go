@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCollectDoctorChecksMissingDir(t *testing.T) {
+	checks := collectDoctorChecks(filepath.Join(t.TempDir(), ".issues"))
+	if len(checks) == 0 || checks[0].status != doctorFail {
+		t.Fatalf("first check = %+v, want a failure for the missing directory", checks)
+	}
+	if checks[0].fix == "" {
+		t.Error("missing directory check should suggest a fix")
+	}
+}
+
+func TestCheckIssuesDir(t *testing.T) {
+	issueFile := func(number int) string {
+		return fmt.Sprintf("---\nnumber: %d\ntitle: Issue\nstate: open\ncreated_at: 2026-01-01T00:00:00Z\nupdated_at: 2026-01-01T00:00:00Z\n---\n", number)
+	}
+
+	// want holds the structure, parse and conflict check results
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []doctorStatus
+	}{
+		{"healthy", map[string]string{"001-a.md": issueFile(1)}, []doctorStatus{doctorOK, doctorOK, doctorOK}},
+		{"legacy structure", map[string]string{"open/001-a.md": issueFile(1)}, []doctorStatus{doctorWarn, doctorOK, doctorOK}},
+		{"parse failure", map[string]string{"001-a.md": issueFile(1), "002-b.md": "---\nnumber: [\n---\n"}, []doctorStatus{doctorOK, doctorWarn, doctorOK}},
+		{"number conflict", map[string]string{"001-a.md": issueFile(1), "001-b.md": issueFile(1)}, []doctorStatus{doctorOK, doctorOK, doctorWarn}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(dir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			checks := checkIssuesDir(dir)
+			if len(checks) != len(tt.want) {
+				t.Fatalf("checkIssuesDir() = %+v, want %d checks", checks, len(tt.want))
+			}
+			for i, c := range checks {
+				if c.status != tt.want[i] {
+					t.Errorf("check %d %q: status = %d, want %d", i, c.message, c.status, tt.want[i])
+				}
+				if c.status == doctorWarn && c.fix == "" {
+					t.Errorf("check %d %q: warning without a fix", i, c.message)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckToolsOnPath(t *testing.T) {
+	// The AI config is read from the user's config directory
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	tests := []struct {
+		name    string
+		tools   []string
+		wantAI  doctorStatus
+		wantGit doctorStatus
+	}{
+		{"nothing installed", nil, doctorWarn, doctorWarn},
+		{"git only", []string{"git"}, doctorWarn, doctorOK},
+		{"AI CLI and git", []string{"claude", "git"}, doctorOK, doctorOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bin := t.TempDir()
+			for _, tool := range tt.tools {
+				if err := os.WriteFile(filepath.Join(bin, tool), []byte("#!/bin/sh\n"), 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+			t.Setenv("PATH", bin)
+
+			if got := checkAIProvider(); got.status != tt.wantAI {
+				t.Errorf("checkAIProvider() = %+v, want status %d", got, tt.wantAI)
+			}
+			if got := checkGit(); got.status != tt.wantGit {
+				t.Errorf("checkGit() = %+v, want status %d", got, tt.wantGit)
+			}
+		})
+	}
+}
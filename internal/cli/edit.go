@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"os/exec"
@@ -12,21 +13,41 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var editRaw bool
+
 var editCmd = &cobra.Command{
-	Use:               "edit <number>",
-	Aliases:           []string{"e"},
-	Short:             "Edit an issue in your editor",
-	Long:              `Open an issue file in your editor for editing.`,
-	Args:              cobra.ExactArgs(1),
+	Use:     "edit [number]",
+	Aliases: []string{"e", "open"},
+	Short:   "Edit an issue in your editor",
+	Long: `Open an issue file in your editor for editing.
+
+After the editor closes, the file is re-parsed. If the frontmatter no longer
+parses, a warning is printed and the file is left as-is. Otherwise the
+frontmatter is normalized (RFC3339 UTC timestamps). Use --raw to keep the
+file exactly as edited.
+
+Without a number in a terminal, the issue is picked interactively.`,
+	Args:              issueArgs(1),
 	ValidArgsFunction: completeIssueNumber,
 	RunE:              runEdit,
 }
 
 func init() {
 	rootCmd.AddCommand(editCmd)
+
+	editCmd.Flags().BoolVar(&editRaw, "raw", false, "Keep the file exactly as edited (no reformatting)")
 }
 
 func runEdit(cmd *cobra.Command, args []string) error {
+	args, err := pickIssueArg(cmd, args, 1)
+	if err != nil {
+		return err
+	}
+
+	if isMultiProjectMode(cmd) {
+		return runMultiProjectEdit(cmd, args)
+	}
+
 	number, err := strconv.Atoi(args[0])
 	if err != nil {
 		return fmt.Errorf("invalid issue number: %s", args[0])
@@ -61,8 +82,77 @@ func runEdit(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	return editIssueFile(iss.FilePath)
+}
+
+// runMultiProjectEdit handles edit for multiple projects ("api/12" or a unique number)
+func runMultiProjectEdit(cmd *cobra.Command, args []string) error {
+	multiStore, err := getMultiStore(cmd)
+	if err != nil {
+		return err
+	}
+
+	pIss, err := multiStore.Resolve(args[0], "")
+	if err != nil {
+		return err
+	}
+
+	return editIssueFile(pIss.FilePath)
+}
+
+// editIssueFile opens the issue file in the editor and verifies it afterwards
+func editIssueFile(filePath string) error {
+	before, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read issue file: %w", err)
+	}
+
 	editor := getEditor()
-	return openInEditor(editor, iss.FilePath)
+	if err := openInEditor(editor, filePath); err != nil {
+		return err
+	}
+
+	return verifyEditedIssue(filePath, before, editRaw)
+}
+
+// verifyEditedIssue re-parses an issue file after editing and warns if it
+// no longer parses. Unless raw is set, a changed file is re-serialized so
+// the frontmatter keeps the canonical format.
+func verifyEditedIssue(filePath string, before []byte, raw bool) error {
+	after, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read issue file: %w", err)
+	}
+
+	edited, err := issue.ParseBytes(after, filePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  %s no longer parses: %v\n", filePath, err)
+		fmt.Fprintf(os.Stderr, "   Run 'zap repair' or edit the file again to fix it.\n")
+		return nil
+	}
+	if err := edited.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  %s: %v\n", filePath, err)
+		fmt.Fprintf(os.Stderr, "   Edit the file again to fix it.\n")
+		return nil
+	}
+
+	if raw || bytes.Equal(before, after) {
+		return nil
+	}
+
+	data, err := issue.Serialize(edited)
+	if err != nil {
+		return fmt.Errorf("failed to serialize issue: %w", err)
+	}
+	if bytes.Equal(data, after) {
+		return nil
+	}
+
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write issue file: %w", err)
+	}
+
+	return nil
 }
 
 // getEditor returns the editor command following Git's priority:
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerifyEditedIssue(t *testing.T) {
+	before := "---\nnumber: 1\ntitle: Test\nstate: open\ncreated_at: 2026-01-17T06:30:00Z\nupdated_at: 2026-01-17T06:30:00Z\n---\n"
+	edited := "---\nnumber: 1\ntitle: Edited\nstate: open\ncreated_at: 2026-01-17 15:30\nupdated_at: 2026-01-17 15:30\n---\n"
+
+	tests := []struct {
+		name     string
+		content  string
+		raw      bool
+		wantSame bool
+	}{
+		{"normalizes edited frontmatter", edited, false, false},
+		{"raw keeps file as edited", edited, true, true},
+		{"broken frontmatter is left alone", "---\nnumber: [\n---\n", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "001-test.md")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := verifyEditedIssue(path, []byte(before), tt.raw); err != nil {
+				t.Fatalf("verifyEditedIssue failed: %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if same := string(data) == tt.content; same != tt.wantSame {
+				t.Errorf("file unchanged = %v, want %v; got:\n%s", same, tt.wantSame, data)
+			}
+			if !tt.wantSame && !strings.Contains(string(data), "2026-01-17T15:30:00Z") {
+				t.Errorf("expected RFC3339 timestamp, got:\n%s", data)
+			}
+		})
+	}
+}
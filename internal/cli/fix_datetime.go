@@ -1,10 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
-	"strconv"
 	"strings"
 	"time"
 
@@ -13,7 +13,7 @@ import (
 )
 
 var fixDatetimeCmd = &cobra.Command{
-	Use:   "fix-datetime-format [number]",
+	Use:   "fix-datetime-format [number...]",
 	Short: "Fix datetime format in issue files",
 	Long: `Standardize datetime format to RFC3339 UTC in all issue files.
 
@@ -23,13 +23,16 @@ to RFC3339 UTC format (e.g., 2026-01-17T06:30:00Z).
 Options:
   --dry-run     Preview changes without modifying files
   --analyze     Analyze current datetime formats without making changes
+  --json        Output the --analyze result as JSON
 
 Examples:
   zap fix-datetime-format --dry-run    # Preview what would change
   zap fix-datetime-format              # Apply to all issues
   zap fix-datetime-format --analyze    # Show format distribution statistics
-  zap fix-datetime-format 1            # Fix only issue #1`,
-	Args:              cobra.MaximumNArgs(1),
+  zap fix-datetime-format --analyze --json  # Statistics as JSON (for CI)
+  zap fix-datetime-format 1            # Fix only issue #1
+  zap fix-datetime-format 1 3 10-20    # Fix issues #1, #3 and #10 through #20`,
+	Args:              cobra.ArbitraryArgs,
 	ValidArgsFunction: completeIssueNumber,
 	RunE:              runFixDatetime,
 }
@@ -37,15 +40,21 @@ Examples:
 var (
 	fixDryRun  bool
 	fixAnalyze bool
+	fixJSON    bool
 )
 
 func init() {
 	rootCmd.AddCommand(fixDatetimeCmd)
 	fixDatetimeCmd.Flags().BoolVar(&fixDryRun, "dry-run", false, "Preview changes only")
 	fixDatetimeCmd.Flags().BoolVar(&fixAnalyze, "analyze", false, "Analyze datetime formats")
+	fixDatetimeCmd.Flags().BoolVar(&fixJSON, "json", false, "Output analysis as JSON (with --analyze)")
 }
 
 func runFixDatetime(cmd *cobra.Command, args []string) error {
+	if fixJSON && !fixAnalyze {
+		return fmt.Errorf("--json requires --analyze")
+	}
+
 	// Get issues directory with discovery info
 	dir, wasDiscovered, err := getIssuesDirWithDiscovery(cmd)
 	if err != nil {
@@ -86,21 +95,33 @@ func runFixDatetime(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	// Filter by number if specified as argument
+	// Filter by numbers and ranges if specified as arguments
 	if len(args) > 0 {
-		number, err := strconv.Atoi(args[0])
+		numbers, err := parseNumberSelectors(args)
 		if err != nil {
-			return fmt.Errorf("invalid issue number: %s", args[0])
+			return err
 		}
-		var filtered []*issue.Issue
+		byNumber := make(map[int]*issue.Issue, len(issues))
 		for _, iss := range issues {
-			if iss.Number == number {
+			byNumber[iss.Number] = iss
+		}
+		var filtered []*issue.Issue
+		var skipped []int
+		for _, number := range numbers {
+			if iss, ok := byNumber[number]; ok {
 				filtered = append(filtered, iss)
-				break
+			} else {
+				skipped = append(skipped, number)
 			}
 		}
+		if len(numbers) > 1 {
+			printSkippedSelectors(skipped, "not found")
+		}
 		if len(filtered) == 0 {
-			return fmt.Errorf("issue #%d not found", number)
+			if len(numbers) == 1 {
+				return fmt.Errorf("issue #%d not found", numbers[0])
+			}
+			return fmt.Errorf("none of the specified issues were found")
 		}
 		issues = filtered
 	}
@@ -288,7 +309,7 @@ func runAnalyzeDatetime(dir string) error {
 		return fmt.Errorf("failed to list issues: %w", err)
 	}
 
-	if len(issues) == 0 {
+	if len(issues) == 0 && !fixJSON {
 		fmt.Println("No issues found.")
 		return nil
 	}
@@ -301,7 +322,7 @@ func runAnalyzeDatetime(dir string) error {
 	for _, iss := range issues {
 		raw, err := issue.GetRawDatetimeInfo(iss.FilePath)
 		if err != nil {
-			fmt.Printf("Warning: failed to read raw datetime for issue #%d: %v\n", iss.Number, err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to read raw datetime for issue #%d: %v\n", iss.Number, err)
 			continue
 		}
 
@@ -332,6 +353,24 @@ func runAnalyzeDatetime(dir string) error {
 		}
 	}
 
+	fields := map[string]map[issue.DatetimeFormat]*formatStats{
+		"created_at": createdStats,
+		"updated_at": updatedStats,
+	}
+	if len(closedStats) > 0 {
+		fields["closed_at"] = closedStats
+	}
+	totalFields, rfc3339Fields, needConversion := summarizeFormatStats(createdStats, updatedStats, closedStats)
+
+	if fixJSON {
+		out, err := json.MarshalIndent(buildDatetimeAnalysisJSON(len(issues), fields, totalFields, rfc3339Fields, needConversion), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
 	// Print results
 	fmt.Println("DateTime Format Analysis")
 	fmt.Println("========================")
@@ -343,36 +382,6 @@ func runAnalyzeDatetime(dir string) error {
 		printFieldStats("closed_at", closedStats)
 	}
 
-	// Summary
-	totalFields := 0
-	rfc3339Fields := 0
-	needConversion := 0
-
-	for fmt, stats := range createdStats {
-		totalFields += stats.count
-		if fmt == issue.FormatRFC3339 {
-			rfc3339Fields += stats.count
-		} else if fmt != issue.FormatEmpty {
-			needConversion += stats.count
-		}
-	}
-	for fmt, stats := range updatedStats {
-		totalFields += stats.count
-		if fmt == issue.FormatRFC3339 {
-			rfc3339Fields += stats.count
-		} else if fmt != issue.FormatEmpty {
-			needConversion += stats.count
-		}
-	}
-	for fmt, stats := range closedStats {
-		totalFields += stats.count
-		if fmt == issue.FormatRFC3339 {
-			rfc3339Fields += stats.count
-		} else if fmt != issue.FormatEmpty {
-			needConversion += stats.count
-		}
-	}
-
 	fmt.Println("Summary")
 	fmt.Println("-------")
 	fmt.Printf("  Total issues:      %d\n", len(issues))
@@ -383,6 +392,61 @@ func runAnalyzeDatetime(dir string) error {
 	return nil
 }
 
+// summarizeFormatStats counts all fields, fields already in RFC3339, and
+// non-empty fields that need conversion.
+func summarizeFormatStats(fields ...map[issue.DatetimeFormat]*formatStats) (total, rfc3339, needConversion int) {
+	for _, stats := range fields {
+		for format, s := range stats {
+			total += s.count
+			if format == issue.FormatRFC3339 {
+				rfc3339 += s.count
+			} else if format != issue.FormatEmpty {
+				needConversion += s.count
+			}
+		}
+	}
+	return total, rfc3339, needConversion
+}
+
+// datetimeAnalysisJSON is the --analyze --json output.
+// Fields maps field name -> format name -> stats.
+type datetimeAnalysisJSON struct {
+	Fields  map[string]map[string]formatStatsJSON `json:"fields"`
+	Summary datetimeSummaryJSON                   `json:"summary"`
+}
+
+type formatStatsJSON struct {
+	Count  int   `json:"count"`
+	Issues []int `json:"issues"`
+}
+
+type datetimeSummaryJSON struct {
+	TotalIssues    int `json:"total_issues"`
+	TotalFields    int `json:"total_fields"`
+	RFC3339        int `json:"rfc3339"`
+	NeedConversion int `json:"need_conversion"`
+}
+
+func buildDatetimeAnalysisJSON(totalIssues int, fields map[string]map[issue.DatetimeFormat]*formatStats, totalFields, rfc3339, needConversion int) datetimeAnalysisJSON {
+	out := datetimeAnalysisJSON{
+		Fields: make(map[string]map[string]formatStatsJSON, len(fields)),
+		Summary: datetimeSummaryJSON{
+			TotalIssues:    totalIssues,
+			TotalFields:    totalFields,
+			RFC3339:        rfc3339,
+			NeedConversion: needConversion,
+		},
+	}
+	for name, stats := range fields {
+		formats := make(map[string]formatStatsJSON, len(stats))
+		for format, s := range stats {
+			formats[string(format)] = formatStatsJSON{Count: s.count, Issues: s.issues}
+		}
+		out.Fields[name] = formats
+	}
+	return out
+}
+
 // formatExamples maps DatetimeFormat to example strings
 var formatExamples = map[issue.DatetimeFormat]string{
 	issue.FormatRFC3339:       "2026-01-17T15:47:00Z",
@@ -272,3 +272,27 @@ func TestSerializeWithClosedAt(t *testing.T) {
 		t.Errorf("Should contain UTC closed_at timestamp, got:\n%s", content)
 	}
 }
+
+func TestSummarizeFormatStats(t *testing.T) {
+	created := map[issue.DatetimeFormat]*formatStats{
+		issue.FormatRFC3339:  {count: 3, issues: []int{1, 2, 3}},
+		issue.FormatDateOnly: {count: 1, issues: []int{4}},
+	}
+	updated := map[issue.DatetimeFormat]*formatStats{
+		issue.FormatRFC3339: {count: 2, issues: []int{1, 2}},
+		issue.FormatEmpty:   {count: 2, issues: []int{3, 4}},
+	}
+
+	total, rfc3339, need := summarizeFormatStats(created, updated, nil)
+	if total != 8 || rfc3339 != 5 || need != 1 {
+		t.Errorf("summarizeFormatStats() = (%d, %d, %d), want (8, 5, 1)", total, rfc3339, need)
+	}
+
+	out := buildDatetimeAnalysisJSON(4, map[string]map[issue.DatetimeFormat]*formatStats{"created_at": created}, total, rfc3339, need)
+	if got := out.Fields["created_at"]["YYYY-MM-DD"]; got.Count != 1 || len(got.Issues) != 1 || got.Issues[0] != 4 {
+		t.Errorf("created_at YYYY-MM-DD = %+v", got)
+	}
+	if out.Summary.TotalIssues != 4 || out.Summary.NeedConversion != 1 {
+		t.Errorf("summary = %+v", out.Summary)
+	}
+}
@@ -35,10 +35,11 @@ Examples:
 }
 
 var (
-	fixNumbersDryRun bool
-	fixNumbersYes    bool
-	fixNumbersAI     string
-	fixNumbersNoAI   bool
+	fixNumbersDryRun  bool
+	fixNumbersYes     bool
+	fixNumbersAI      string
+	fixNumbersAIModel string
+	fixNumbersNoAI    bool
 )
 
 func init() {
@@ -47,6 +48,7 @@ func init() {
 	fixNumbersCmd.Flags().BoolVar(&fixNumbersDryRun, "dry-run", false, "Show what would be changed without modifying files")
 	fixNumbersCmd.Flags().BoolVarP(&fixNumbersYes, "yes", "y", false, "Skip confirmation prompts")
 	fixNumbersCmd.Flags().StringVar(&fixNumbersAI, "ai", "", "AI CLI to use (claude, codex, gemini)")
+	fixNumbersCmd.Flags().StringVar(&fixNumbersAIModel, "ai-model", "", "AI model to use (overrides ai.yaml)")
 	fixNumbersCmd.Flags().BoolVar(&fixNumbersNoAI, "no-ai", false, "Skip AI verification")
 }
 
@@ -111,7 +113,7 @@ func runFixNumbers(cmd *cobra.Command, args []string) error {
 	// Get AI client for verification (unless --no-ai)
 	var client ai.Client
 	if !fixNumbersNoAI {
-		client, err = getAIClient(fixNumbersAI)
+		client, err = getAIClient(dir, fixNumbersAI, fixNumbersAIModel)
 		if err != nil {
 			return err
 		}
@@ -133,6 +135,8 @@ func runFixNumbers(cmd *cobra.Command, args []string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout*time.Duration(len(conflicts)))
 	defer cancel()
 
+	undo := newUndoRecorder(dir, "fix-numbers")
+
 	successCount := 0
 	for i, conflict := range conflicts {
 		fmt.Printf("Processing conflict %d/%d...\n", i+1, len(conflicts))
@@ -158,6 +162,10 @@ func runFixNumbers(cmd *cobra.Command, args []string) error {
 		}
 
 		// Apply the fix
+		if fi := conflict.ToRenumber; fi != nil {
+			undo.track(fi.FilePath)
+			undo.track(renumberedPath(fi, conflict.NewNumber))
+		}
 		if err := applyConflictFix(conflict); err != nil {
 			fmt.Printf("  ❌ Failed to fix: %v\n", err)
 			continue
@@ -167,6 +175,7 @@ func runFixNumbers(cmd *cobra.Command, args []string) error {
 		successCount++
 	}
 
+	undo.saveOrWarn()
 	fmt.Printf("\n✅ Resolved %d/%d conflicts.\n", successCount, len(conflicts))
 	return nil
 }
@@ -313,15 +322,8 @@ func updateFrontmatterNumber(fi *issue.FileInfo, newNumber int) error {
 
 // renumberIssue renames the file and updates frontmatter.
 func renumberIssue(fi *issue.FileInfo, newNumber int) error {
-	// Extract slug from current filename (e.g., "001-feature-name.md" -> "feature-name")
-	slug := extractSlugFromFilename(fi.FileName)
-	if slug == "" {
-		slug = "issue"
-	}
-
-	// Build new filename
-	newFilename := fmt.Sprintf("%03d-%s.md", newNumber, slug)
-	newPath := filepath.Join(filepath.Dir(fi.FilePath), newFilename)
+	newPath := renumberedPath(fi, newNumber)
+	newFilename := filepath.Base(newPath)
 
 	// Check if new path already exists
 	if _, err := os.Stat(newPath); err == nil {
@@ -352,6 +354,17 @@ func renumberIssue(fi *issue.FileInfo, newNumber int) error {
 	return nil
 }
 
+// renumberedPath returns the path a file gets when renumbered, keeping its slug.
+func renumberedPath(fi *issue.FileInfo, newNumber int) string {
+	// Extract slug from current filename (e.g., "001-feature-name.md" -> "feature-name")
+	slug := extractSlugFromFilename(fi.FileName)
+	if slug == "" {
+		slug = "issue"
+	}
+
+	return filepath.Join(filepath.Dir(fi.FilePath), fmt.Sprintf("%03d-%s.md", newNumber, slug))
+}
+
 // extractSlugFromFilename extracts the slug part from a filename.
 // e.g., "001-feature-name.md" -> "feature-name"
 func extractSlugFromFilename(filename string) string {
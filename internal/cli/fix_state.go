@@ -11,21 +11,24 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var fixStateCmd = &cobra.Command{
-	Use:   "fix-state",
-	Short: "Fix issues with invalid state values",
-	Long: `Scan for and fix issues with invalid state values.
+// fixStateLongFormat is the Long help of fix-state; %s is the list of states.
+const fixStateLongFormat = `Scan for and fix issues with invalid state values.
 
 This command finds issues with deprecated or invalid states (e.g., "in-progress")
 and helps convert them to valid states (e.g., "wip").
 
-Valid states: open, wip, done, closed
+Valid states: %s (states can be added in .zap.yml)
 
 Examples:
   zap fix-state              # Interactive mode - asks before fixing
   zap fix-state --dry-run    # Show what would be fixed without changing
-  zap fix-state --yes        # Fix all without asking`,
-	RunE: runFixState,
+  zap fix-state --yes        # Fix all without asking`
+
+var fixStateCmd = &cobra.Command{
+	Use:   "fix-state",
+	Short: "Fix issues with invalid state values",
+	Long:  fmt.Sprintf(fixStateLongFormat, issue.ValidStates()),
+	RunE:  runFixState,
 }
 
 var (
@@ -110,9 +113,8 @@ func runFixState(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
-		// Check if state is valid
-		_, valid := issue.ParseState(state)
-		if valid {
+		// Check if state is valid; aliases are rewritten to the state itself
+		if parsed, ok := issue.ParseState(state); ok && string(parsed) == state {
 			continue
 		}
 
@@ -213,6 +215,10 @@ func extractStateFromContent(content string) string {
 func suggestState(invalidState string) string {
 	lower := strings.ToLower(invalidState)
 
+	if state, ok := issue.ParseState(lower); ok {
+		return string(state)
+	}
+
 	// Check known mappings
 	if suggestion, ok := knownStateMappings[lower]; ok {
 		return string(suggestion)
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+// gitCommitMarker starts each commit header in gitStateTimeline's git log
+// output; git writes the NUL for the %x00 in the format.
+const gitCommitMarker = "\x00commit "
+
+// stateTransition is a state change recovered from git history.
+type stateTransition struct {
+	State  issue.State
+	At     time.Time
+	Commit string
+}
+
+// gitStateTimeline reconstructs the state timeline of an issue file from
+// the state: lines its commits added, oldest first. Renames are followed.
+func gitStateTimeline(filePath string) ([]stateTransition, error) {
+	cmd := exec.Command("git", "log", "--follow", "-p", "--no-color", "--format=%x00commit %h %aI", "--", filepath.Base(filePath))
+	cmd.Dir = filepath.Dir(filePath)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log failed: %w", err)
+	}
+	return parseStateTimeline(string(output)), nil
+}
+
+// parseStateTimeline extracts transitions from git log -p output, newest
+// commit first as git prints it. Only the first added state: line of each
+// commit counts, and commits that keep the previous state are dropped.
+func parseStateTimeline(output string) []stateTransition {
+	var commits []stateTransition
+	var current *stateTransition
+	found := false
+
+	for _, line := range strings.Split(output, "\n") {
+		if header, ok := strings.CutPrefix(line, gitCommitMarker); ok {
+			current, found = nil, false
+			hash, date, _ := strings.Cut(header, " ")
+			at, err := time.Parse(time.RFC3339, strings.TrimSpace(date))
+			if err != nil {
+				continue
+			}
+			commits = append(commits, stateTransition{At: at, Commit: hash})
+			current = &commits[len(commits)-1]
+			continue
+		}
+		if current == nil || found {
+			continue
+		}
+		if value, ok := strings.CutPrefix(line, "+state:"); ok {
+			value = strings.Trim(strings.TrimSpace(value), `"'`)
+			current.State = issue.State(value)
+			if state, ok := issue.ParseState(value); ok {
+				current.State = state
+			}
+			found = true
+		}
+	}
+
+	var timeline []stateTransition
+	for i := len(commits) - 1; i >= 0; i-- {
+		c := commits[i]
+		if c.State == "" || (len(timeline) > 0 && timeline[len(timeline)-1].State == c.State) {
+			continue
+		}
+		timeline = append(timeline, c)
+	}
+	return timeline
+}
+
+// printGitStateTimeline prints the state timeline of an issue from git.
+func printGitStateTimeline(iss *issue.Issue) {
+	timeline, err := gitStateTimeline(iss.FilePath)
+	if err != nil || len(timeline) == 0 {
+		fmt.Printf("Git history: %s\n", colorize("not available (file is not committed to git)", colorGray))
+		return
+	}
+
+	fmt.Printf("Git history:\n")
+	for _, t := range timeline {
+		fmt.Printf("  %s  %s %-6s %s\n", t.At.Local().Format("2006-01-02 15:04"), glyph("→", "->"), t.State, colorize(t.Commit, colorGray))
+	}
+}
+
+// issueVersion is an issue file as committed to git.
+type issueVersion struct {
+	Commit string
+	Author string
+	At     time.Time
+	Issue  *issue.Issue
+}
+
+// gitIssueVersions returns the committed versions of an issue file, oldest
+// first. Renames are followed; versions that fail to parse are skipped.
+func gitIssueVersions(filePath string) ([]issueVersion, error) {
+	dir := filepath.Dir(filePath)
+	cmd := exec.Command("git", "log", "--follow", "--name-only", "--format=%x00commit %h %aI %an", "--", filepath.Base(filePath))
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log failed: %w", err)
+	}
+
+	var versions []issueVersion
+	for _, record := range strings.Split(string(output), gitCommitMarker) {
+		lines := strings.Split(strings.TrimSpace(record), "\n")
+		fields := strings.SplitN(lines[0], " ", 3)
+		path := strings.TrimSpace(lines[len(lines)-1])
+		if len(fields) < 3 || len(lines) < 2 || path == "" {
+			continue // Empty record or a merge commit without files
+		}
+		at, err := time.Parse(time.RFC3339, fields[1])
+		if err != nil {
+			continue
+		}
+		iss := parseIssueAtRef(dir, fields[0], path)
+		if iss == nil {
+			continue
+		}
+		versions = append(versions, issueVersion{Commit: fields[0], Author: fields[2], At: at, Issue: iss})
+	}
+
+	slices.Reverse(versions)
+	return versions, nil
+}
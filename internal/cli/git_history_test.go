@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+func TestParseStateTimeline(t *testing.T) {
+	// git log -p prints the newest commit first
+	output := gitCommitMarker + `ccc3333 2026-01-18T10:00:00+09:00
+
+diff --git a/.issues/001-login.md b/.issues/001-login.md
+-state: wip
++state: done
++closed_at: "2026-01-18T01:00:00Z"
+ state_history:
++    - state: done
+` + gitCommitMarker + `bbb2222 2026-01-17T10:00:00+09:00
+
+diff --git a/.issues/001-login.md b/.issues/001-login.md
+-title: Login
++title: Login page crashes
+` + gitCommitMarker + `aaa1111 2026-01-16T10:00:00+09:00
+
+diff --git a/.issues/001-login.md b/.issues/001-login.md
+-state: open
++state: "in-progress"
+` + gitCommitMarker + `9999999 2026-01-15T10:00:00+09:00
+
+diff --git a/.issues/001-login.md b/.issues/001-login.md
++state: open
++title: Login
+`
+
+	timeline := parseStateTimeline(output)
+	want := []struct {
+		state  issue.State
+		commit string
+	}{
+		{issue.StateOpen, "9999999"},
+		{issue.StateWip, "aaa1111"},
+		{issue.StateDone, "ccc3333"},
+	}
+	if len(timeline) != len(want) {
+		t.Fatalf("parseStateTimeline() = %+v, want %d transitions", timeline, len(want))
+	}
+	for i, w := range want {
+		if timeline[i].State != w.state || timeline[i].Commit != w.commit {
+			t.Errorf("timeline[%d] = %s %s, want %s %s", i, timeline[i].State, timeline[i].Commit, w.state, w.commit)
+		}
+	}
+	if got := timeline[0].At.UTC().Format("2006-01-02T15:04"); got != "2026-01-15T01:00" {
+		t.Errorf("timeline[0].At = %s, want 2026-01-15T01:00", got)
+	}
+
+	if got := parseStateTimeline(""); len(got) != 0 {
+		t.Errorf("parseStateTimeline(\"\") = %+v, want empty", got)
+	}
+}
@@ -20,15 +20,18 @@ Supported agents:
   claude    Create CLAUDE.md for Claude Code
   codex     Create AGENTS.md for OpenAI Codex CLI
   gemini    Create GEMINI.md for Google Gemini
+  cursor    Create .cursorrules for Cursor
+  windsurf  Create .windsurfrules for Windsurf
 
 Either an agent name or --path flag is required.
 
 Examples:
   zap init claude                  # Create CLAUDE.md in project root
   zap init codex                   # Create AGENTS.md in project root
+  zap init cursor                  # Create .cursorrules in project root
   zap init --path AI_GUIDE.md      # Create AI_GUIDE.md directly`,
 	Args:      cobra.MaximumNArgs(1),
-	ValidArgs: []string{"claude", "codex", "gemini"},
+	ValidArgs: []string{"claude", "codex", "gemini", "cursor", "windsurf"},
 	RunE:      runInit,
 }
 
@@ -37,20 +40,22 @@ var initPath string
 func init() {
 	rootCmd.AddCommand(initCmd)
 
-	initCmd.Flags().StringVar(&initPath, "path", "", "File path for instruction file (default: CLAUDE.md/AGENTS.md/GEMINI.md)")
+	initCmd.Flags().StringVar(&initPath, "path", "", "File path for instruction file (default: per-agent file, e.g. CLAUDE.md)")
 }
 
 // agentFilenames maps agent names to their default filenames
 var agentFilenames = map[string]string{
-	"claude": "CLAUDE.md",
-	"codex":  "AGENTS.md",
-	"gemini": "GEMINI.md",
+	"claude":   "CLAUDE.md",
+	"codex":    "AGENTS.md",
+	"gemini":   "GEMINI.md",
+	"cursor":   ".cursorrules",
+	"windsurf": ".windsurfrules",
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
 	// Require either agent argument or --path flag
 	if len(args) == 0 && initPath == "" {
-		return fmt.Errorf("either an agent name (claude, codex, gemini) or --path flag is required")
+		return fmt.Errorf("either an agent name (claude, codex, gemini, cursor, windsurf) or --path flag is required")
 	}
 
 	// Get project directory from -C flag
@@ -73,7 +78,7 @@ func runInit(cmd *cobra.Command, args []string) error {
 		agent := strings.ToLower(args[0])
 		filename, ok := agentFilenames[agent]
 		if !ok {
-			return fmt.Errorf("unsupported agent: %s (supported: claude, codex, gemini)", agent)
+			return fmt.Errorf("unsupported agent: %s (supported: claude, codex, gemini, cursor, windsurf)", agent)
 		}
 		targetFile = filepath.Join(projectDir, filename)
 	}
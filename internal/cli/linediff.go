@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffLine is one line of a line diff: ' ' unchanged, '-' removed, '+' added.
+type diffLine struct {
+	Op   byte
+	Text string
+}
+
+// diffLines computes a line diff of a and b from their longest common
+// subsequence. The common prefix and suffix are trimmed first, so the
+// quadratic part only covers the changed region.
+func diffLines(a, b []string) []diffLine {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var result []diffLine
+	for _, line := range a[:prefix] {
+		result = append(result, diffLine{' ', line})
+	}
+
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	// lcs[i][j] is the LCS length of midA[i:] and midB[j:]
+	lcs := make([][]int, len(midA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(midB)+1)
+	}
+	for i := len(midA) - 1; i >= 0; i-- {
+		for j := len(midB) - 1; j >= 0; j-- {
+			if midA[i] == midB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < len(midA) || j < len(midB) {
+		switch {
+		case i < len(midA) && j < len(midB) && midA[i] == midB[j]:
+			result = append(result, diffLine{' ', midA[i]})
+			i++
+			j++
+		case i < len(midA) && (j == len(midB) || lcs[i+1][j] >= lcs[i][j+1]):
+			result = append(result, diffLine{'-', midA[i]})
+			i++
+		default:
+			result = append(result, diffLine{'+', midB[j]})
+			j++
+		}
+	}
+
+	for _, line := range a[len(a)-suffix:] {
+		result = append(result, diffLine{' ', line})
+	}
+	return result
+}
+
+// changedBodyLines returns the removed and added lines between two issue
+// bodies as "- line" / "+ line", limited to limit lines plus a note of how
+// many were left out. Blank lines are skipped.
+func changedBodyLines(old, new string, limit int) []string {
+	var changed []string
+	for _, d := range diffLines(strings.Split(old, "\n"), strings.Split(new, "\n")) {
+		if d.Op != ' ' && strings.TrimSpace(d.Text) != "" {
+			changed = append(changed, string(d.Op)+" "+d.Text)
+		}
+	}
+	if len(changed) > limit {
+		more := len(changed) - limit
+		changed = append(changed[:limit], fmt.Sprintf("... (%d more lines)", more))
+	}
+	return changed
+}
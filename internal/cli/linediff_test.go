@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	render := func(diff []diffLine) string {
+		var parts []string
+		for _, d := range diff {
+			parts = append(parts, string(d.Op)+d.Text)
+		}
+		return strings.Join(parts, ",")
+	}
+
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"equal", "a\nb", "a\nb", " a, b"},
+		{"insert in middle", "a\nc", "a\nb\nc", " a,+b, c"},
+		{"delete", "a\nb\nc", "a\nc", " a,-b, c"},
+		{"replace", "a\nb\nc", "a\nx\nc", " a,-b,+x, c"},
+		{"shifted lines", "a\nb\nc\nd", "b\nc\nd\ne", "-a, b, c, d,+e"},
+		{"from empty", "", "a", "-,+a"},
+	}
+	for _, tt := range tests {
+		got := render(diffLines(strings.Split(tt.a, "\n"), strings.Split(tt.b, "\n")))
+		if got != tt.want {
+			t.Errorf("%s: diffLines() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestChangedBodyLines(t *testing.T) {
+	old := "## Steps\n\n1. Open\n2. Click\n"
+	new := "## Steps\n\n1. Open\n2. Click save\n3. Reload\n"
+	want := []string{"- 2. Click", "+ 2. Click save", "+ 3. Reload"}
+	if got := changedBodyLines(old, new, 4); !slices.Equal(got, want) {
+		t.Errorf("changedBodyLines() = %q, want %q", got, want)
+	}
+
+	want = []string{"- 2. Click", "+ 2. Click save", "... (1 more lines)"}
+	if got := changedBodyLines(old, new, 2); !slices.Equal(got, want) {
+		t.Errorf("changedBodyLines() truncated = %q, want %q", got, want)
+	}
+}
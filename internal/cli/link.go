@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+var linkCmd = &cobra.Command{
+	Use:   "link <a> <type> <b>",
+	Short: "Record a relationship between two issues",
+	Long: `Record a typed relationship between two issues.
+
+Both issues are updated so the link can be seen from either side:
+
+  relates-to   relates_to on both issues
+  duplicates   duplicates on <a>, duplicated_by on <b>
+  blocks       blocks on <a>, blocked_by on <b>
+
+Examples:
+  zap link 5 blocks 7          # 5 blocks 7, 7 is blocked by 5
+  zap link 12 duplicates 3
+  zap link 4 relates-to 9`,
+	Args:              cobra.ExactArgs(3),
+	ValidArgsFunction: completeIssueNumber,
+	RunE:              runLink,
+}
+
+var unlinkCmd = &cobra.Command{
+	Use:   "unlink <a> <type> <b>",
+	Short: "Remove a relationship between two issues",
+	Long: `Remove a relationship recorded with 'zap link' from both issues.
+
+Examples:
+  zap unlink 5 blocks 7
+  zap unlink 4 relates-to 9`,
+	Args:              cobra.ExactArgs(3),
+	ValidArgsFunction: completeIssueNumber,
+	RunE:              runUnlink,
+}
+
+func init() {
+	rootCmd.AddCommand(linkCmd)
+	rootCmd.AddCommand(unlinkCmd)
+}
+
+func runLink(cmd *cobra.Command, args []string) error {
+	return updateLink(cmd, args, "link")
+}
+
+func runUnlink(cmd *cobra.Command, args []string) error {
+	return updateLink(cmd, args, "unlink")
+}
+
+// updateLink adds or removes the link described by args ("<a> <type> <b>")
+// on both issues and saves them.
+func updateLink(cmd *cobra.Command, args []string, op string) error {
+	a, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid issue number: %s", args[0])
+	}
+	linkType, ok := issue.ParseLinkType(args[1])
+	if !ok {
+		var valid []string
+		for _, t := range issue.AllLinkTypes() {
+			valid = append(valid, string(t))
+		}
+		return fmt.Errorf("invalid link type: %s (valid: %s)", args[1], strings.Join(valid, ", "))
+	}
+	b, err := strconv.Atoi(args[2])
+	if err != nil {
+		return fmt.Errorf("invalid issue number: %s", args[2])
+	}
+
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	store := issue.NewStore(dir)
+	from, err := store.Get(a)
+	if err != nil {
+		return err
+	}
+	to, err := store.Get(b)
+	if err != nil {
+		return err
+	}
+
+	var changed bool
+	if op == "link" {
+		if changed, err = issue.Link(from, to, linkType); err != nil {
+			return err
+		}
+	} else {
+		changed = issue.Unlink(from, to, linkType)
+	}
+
+	if !changed {
+		if op == "link" {
+			fmt.Printf("#%d already %s #%d\n", a, linkType, b)
+		} else {
+			fmt.Printf("#%d does not %s #%d\n", a, linkVerb(linkType), b)
+		}
+		return nil
+	}
+
+	undo := newUndoRecorder(dir, fmt.Sprintf("%s %d %s %d", op, a, linkType, b))
+	undo.track(from.FilePath)
+	undo.track(to.FilePath)
+
+	now := time.Now().UTC()
+	for _, iss := range []*issue.Issue{from, to} {
+		iss.UpdatedAt = now
+		if err := store.Save(iss); err != nil {
+			undo.saveOrWarn()
+			return err
+		}
+	}
+	undo.saveOrWarn()
+
+	if op == "link" {
+		fmt.Printf("✅ #%d %s #%d\n", a, linkType, b)
+	} else {
+		fmt.Printf("✅ Unlinked #%d %s #%d\n", a, linkType, b)
+	}
+	return nil
+}
+
+// linkVerb is the bare verb for a link type, for "does not <verb>" messages.
+func linkVerb(t issue.LinkType) string {
+	switch t {
+	case issue.LinkDuplicates:
+		return "duplicate"
+	case issue.LinkBlocks:
+		return "block"
+	default:
+		return "relate to"
+	}
+}
@@ -2,8 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/itda-work/zap/internal/issue"
 	"github.com/itda-work/zap/internal/project"
@@ -21,23 +26,46 @@ var listCmd = &cobra.Command{
 var (
 	listAll        bool
 	listState      string
-	listLabel      string
-	listAssignee   string
+	listLabels     []string
+	listAssignees  []string
+	listUnassigned bool
+	listExcludeLbl []string
+	listExcludeAsg []string
+	listMatch      string
 	listQuiet      bool
 	listSearch     string
 	listTitleOnly  bool
 	listDateFilter DateFilter
 	listRefs       bool
 	listNoDate     bool
+	listLimit      int
+	listOffset     int
+	listModified   bool
+	listPreview    int
+	listCountOnly  bool
+	listFormat     string
 )
 
+// defaultListPreview is the preview length used for a bare --preview.
+const defaultListPreview = 60
+
+// listModifiedFiles holds uncommitted issue files (absolute path -> git
+// status code) when --modified is set, and is used to mark them in output
+var listModifiedFiles map[string]string
+
 func init() {
 	rootCmd.AddCommand(listCmd)
 
 	listCmd.Flags().BoolVarP(&listAll, "all", "a", false, "Show all issues including done and closed")
-	listCmd.Flags().StringVarP(&listState, "state", "s", "", "Filter by state (open, wip, done, closed)")
-	listCmd.Flags().StringVarP(&listLabel, "label", "l", "", "Filter by label")
-	listCmd.Flags().StringVar(&listAssignee, "assignee", "", "Filter by assignee")
+	listCmd.Flags().StringVarP(&listState, "state", "s", "", "Filter by state ("+issue.ValidStates()+")")
+	listCmd.Flags().StringSliceVarP(&listLabels, "label", "l", nil, "Filter by label (comma-separated or repeated)")
+	listCmd.Flags().StringSliceVar(&listAssignees, "assignee", nil, "Filter by assignee (@me for your git user; comma-separated or repeated)")
+	listCmd.Flags().BoolVar(&listUnassigned, "unassigned", false, "Show only issues without assignees")
+	listCmd.Flags().StringSliceVar(&listExcludeLbl, "exclude-label", nil, "Hide issues with this label (comma-separated or repeated)")
+	listCmd.Flags().StringSliceVar(&listExcludeAsg, "exclude-assignee", nil, "Hide issues assigned to this person (comma-separated or repeated)")
+	listCmd.Flags().StringVar(&listMatch, "match", "any", "With several labels/assignees: any or all must match")
+	_ = listCmd.RegisterFlagCompletionFunc("label", completeLabels)
+	_ = listCmd.RegisterFlagCompletionFunc("assignee", completeAssignees)
 	listCmd.Flags().BoolVarP(&listQuiet, "quiet", "q", false, "Suppress parse failure warnings")
 	listCmd.Flags().StringVarP(&listSearch, "search", "S", "", "Search in title and body")
 	listCmd.Flags().BoolVar(&listTitleOnly, "title-only", false, "Search in title only (use with --search)")
@@ -57,12 +85,41 @@ func init() {
 
 	// Date display options
 	listCmd.Flags().BoolVar(&listNoDate, "no-date", false, "Hide updated time from output")
+
+	// Pagination options
+	listCmd.Flags().IntVar(&listLimit, "limit", 0, "Show at most N issues (0 = no limit)")
+	listCmd.Flags().IntVar(&listOffset, "offset", 0, "Skip the first N issues")
+
+	// Git options
+	listCmd.Flags().BoolVar(&listModified, "modified", false, "Show only issues with uncommitted git changes (all states)")
+	listCmd.Flags().IntVar(&listPreview, "preview", 0, "Show up to N characters of the body's first line after the title (--preview=N)")
+	listCmd.Flags().Lookup("preview").NoOptDefVal = fmt.Sprint(defaultListPreview)
+	listCmd.Flags().BoolVar(&listCountOnly, "count-only", false, "Print only the number of matching issues (ignores --limit/--offset)")
+	listCmd.Flags().StringVarP(&listFormat, "format", "f", "text", "Output format (text, json, jsonl)")
 }
 
 func runList(cmd *cobra.Command, args []string) error {
+	if err := checkPreviewArgs(args, cmd.Flags().Changed("preview")); err != nil {
+		return err
+	}
+	if listLimit < 0 || listOffset < 0 {
+		return fmt.Errorf("--limit and --offset must not be negative")
+	}
+	if listFormat != "text" && listFormat != "json" && listFormat != "jsonl" {
+		return fmt.Errorf("invalid format: %s (valid: text, json, jsonl)", listFormat)
+	}
+
+	filter, err := buildIssueFilter(listLabels, listAssignees, listMatch, listUnassigned)
+	if err != nil {
+		return err
+	}
+	if filter, err = addIssueExclusions(filter, listExcludeLbl, listExcludeAsg); err != nil {
+		return err
+	}
+
 	// Check for multi-project mode
 	if isMultiProjectMode(cmd) {
-		return runMultiProjectList(cmd, args)
+		return runMultiProjectList(cmd, args, filter)
 	}
 
 	// Single project mode (existing behavior)
@@ -71,15 +128,18 @@ func runList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 	store := issue.NewStore(dir)
+	loadLabelColors(dir)
 
 	// Get all issues for statistics and print stats header
-	allIssues, err := store.List(issue.AllStates()...)
-	if err != nil {
-		return fmt.Errorf("failed to list issues: %w", err)
+	if !listCountOnly && listFormat == "text" {
+		allIssues, err := store.List(issue.AllStates()...)
+		if err != nil {
+			return fmt.Errorf("failed to list issues: %w", err)
+		}
+		stats := calculateStats(allIssues)
+		printWatchStats(stats)
+		fmt.Println(hrule("─"))
 	}
-	stats := calculateStats(allIssues)
-	printWatchStats(stats)
-	fmt.Println(strings.Repeat("─", 60))
 
 	var states []issue.State
 
@@ -89,22 +149,13 @@ func runList(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("invalid state: %s", listState)
 		}
 		states = []issue.State{state}
-	} else if listAll {
+	} else if listAll || listModified {
 		states = issue.AllStates()
 	} else {
 		states = issue.ActiveStates()
 	}
 
-	var issues []*issue.Issue
-
-	if listLabel != "" {
-		issues, err = store.FilterByLabel(listLabel, states...)
-	} else if listAssignee != "" {
-		issues, err = store.FilterByAssignee(listAssignee, states...)
-	} else {
-		issues, err = store.List(states...)
-	}
-
+	issues, err := store.ListMatching(filter.Match, states...)
 	if err != nil {
 		return fmt.Errorf("failed to list issues: %w", err)
 	}
@@ -112,7 +163,7 @@ func runList(cmd *cobra.Command, args []string) error {
 	// Include recently closed issues if not showing all and not filtering by specific state
 	recentClosedDuration := getRecentClosedDuration()
 	if !listAll && listState == "" && recentClosedDuration > 0 {
-		recentIssues, err := getRecentlyClosedIssues(store, recentClosedDuration, listLabel, listAssignee)
+		recentIssues, err := getRecentlyClosedIssues(store, recentClosedDuration, filter)
 		if err == nil && len(recentIssues) > 0 {
 			issues = mergeIssues(issues, recentIssues)
 		}
@@ -123,6 +174,15 @@ func runList(cmd *cobra.Command, args []string) error {
 		issues = filterBySearch(issues, listSearch, listTitleOnly)
 	}
 
+	// Keep only issues with uncommitted changes
+	if listModified {
+		listModifiedFiles, err = issue.ModifiedFiles(dir)
+		if err != nil {
+			return fmt.Errorf("--modified: %w", err)
+		}
+		issues = filterModified(issues)
+	}
+
 	// Apply date filter if specified
 	if !listDateFilter.IsEmpty() {
 		issues, err = FilterIssuesByDate(issues, &listDateFilter)
@@ -131,10 +191,15 @@ func runList(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if listCountOnly {
+		fmt.Println(len(issues))
+		return nil
+	}
+
 	// Get warnings from store
 	warnings := store.Warnings()
 
-	if len(issues) == 0 && len(warnings) == 0 {
+	if len(issues) == 0 && len(warnings) == 0 && listFormat == "text" {
 		fmt.Println("No issues found.")
 		return nil
 	}
@@ -148,6 +213,18 @@ func runList(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if listFormat != "text" {
+		sortIssuesByStateAndTime(issues)
+		start, end := pageBounds(len(issues), listOffset, listLimit)
+		page := issues[start:end]
+		if !listQuiet && len(warnings) > 0 {
+			fmt.Fprintf(os.Stderr, "⚠️  %d issue files failed to parse (run 'zap list' to see them)\n", len(warnings))
+		}
+		return writeIssueRecords(os.Stdout, listFormat, len(page), func(i int) IssueRecordJSON {
+			return newIssueRecord(page[i], "", refGraph)
+		})
+	}
+
 	if len(issues) > 0 {
 		// Sort by state priority (done → closed → wip → open), then by UpdatedAt descending
 		sortIssuesByStateAndTime(issues)
@@ -163,24 +240,32 @@ func runList(cmd *cobra.Command, args []string) error {
 }
 
 // runMultiProjectList handles listing for multiple projects
-func runMultiProjectList(cmd *cobra.Command, args []string) error {
+func runMultiProjectList(cmd *cobra.Command, args []string, filter issue.Filter) error {
 	multiStore, err := getMultiStore(cmd)
 	if err != nil {
 		return err
 	}
 
-	// Get all issues for statistics and print stats header
-	allProjectIssues, err := multiStore.ListAll(issue.AllStates()...)
-	if err != nil {
-		return fmt.Errorf("failed to list issues: %w", err)
+	var dirs []string
+	for _, proj := range multiStore.Projects() {
+		dirs = append(dirs, proj.Store.BaseDir())
 	}
-	allIssues := make([]*issue.Issue, len(allProjectIssues))
-	for i, pIss := range allProjectIssues {
-		allIssues[i] = pIss.Issue
+	loadLabelColors(dirs...)
+
+	// Get all issues for statistics and print stats header
+	if !listCountOnly && listFormat == "text" {
+		allProjectIssues, err := multiStore.ListAll(issue.AllStates()...)
+		if err != nil {
+			return fmt.Errorf("failed to list issues: %w", err)
+		}
+		allIssues := make([]*issue.Issue, len(allProjectIssues))
+		for i, pIss := range allProjectIssues {
+			allIssues[i] = pIss.Issue
+		}
+		stats := calculateStats(allIssues)
+		printWatchStats(stats)
+		fmt.Println(hrule("─"))
 	}
-	stats := calculateStats(allIssues)
-	printWatchStats(stats)
-	fmt.Println(strings.Repeat("─", 60))
 
 	var states []issue.State
 	if listState != "" {
@@ -189,31 +274,45 @@ func runMultiProjectList(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("invalid state: %s", listState)
 		}
 		states = []issue.State{state}
-	} else if listAll {
+	} else if listAll || listModified {
 		states = issue.AllStates()
 	} else {
 		states = issue.ActiveStates()
 	}
 
-	var projectIssues []*project.ProjectIssue
-
-	if listLabel != "" {
-		projectIssues, err = multiStore.FilterByLabel(listLabel, states...)
-	} else if listAssignee != "" {
-		projectIssues, err = multiStore.FilterByAssignee(listAssignee, states...)
-	} else {
-		projectIssues, err = multiStore.ListAll(states...)
-	}
-
+	projectIssues, err := multiStore.ListAll(states...)
 	if err != nil {
 		return fmt.Errorf("failed to list issues: %w", err)
 	}
+	projectIssues = filterProjectIssues(projectIssues, filter)
 
 	// Apply search filter
 	if listSearch != "" {
 		projectIssues = filterProjectIssuesBySearch(projectIssues, listSearch, listTitleOnly)
 	}
 
+	// Keep only issues with uncommitted changes
+	if listModified {
+		listModifiedFiles = make(map[string]string)
+		for _, proj := range multiStore.Projects() {
+			files, err := issue.ModifiedFiles(proj.Store.BaseDir())
+			if err != nil {
+				return fmt.Errorf("--modified: %w", err)
+			}
+			for path, code := range files {
+				listModifiedFiles[path] = code
+			}
+		}
+
+		var filtered []*project.ProjectIssue
+		for _, pIss := range projectIssues {
+			if modifiedStatus(pIss.FilePath) != "" {
+				filtered = append(filtered, pIss)
+			}
+		}
+		projectIssues = filtered
+	}
+
 	// Apply date filter
 	if !listDateFilter.IsEmpty() {
 		projectIssues, err = filterProjectIssuesByDate(projectIssues, &listDateFilter)
@@ -222,9 +321,26 @@ func runMultiProjectList(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if listCountOnly {
+		fmt.Println(len(projectIssues))
+		return nil
+	}
+
 	// Get warnings from all projects
 	warnings := multiStore.Warnings()
 
+	if listFormat != "text" {
+		sortProjectIssuesByStateAndTime(projectIssues)
+		start, end := pageBounds(len(projectIssues), listOffset, listLimit)
+		page := projectIssues[start:end]
+		if !listQuiet && len(warnings) > 0 {
+			fmt.Fprintf(os.Stderr, "⚠️  %d issue files failed to parse (run 'zap list' to see them)\n", len(warnings))
+		}
+		return writeIssueRecords(os.Stdout, listFormat, len(page), func(i int) IssueRecordJSON {
+			return newIssueRecord(page[i].Issue, page[i].Project, nil)
+		})
+	}
+
 	if len(projectIssues) == 0 && len(warnings) == 0 {
 		fmt.Println("No issues found.")
 		return nil
@@ -245,24 +361,13 @@ func runMultiProjectList(cmd *cobra.Command, args []string) error {
 }
 
 func printIssueList(issues []*issue.Issue, skippedCount int, keyword string, refGraph *issue.RefGraph, recentClosedDuration time.Duration) {
-	// 상태별 텍스트 태그와 색상
-	stateStyle := map[issue.State]struct {
-		tag        string
-		color      string
-		titleColor string
-	}{
-		issue.StateOpen:   {"[open]", "", ""},
-		issue.StateWip:    {"[wip]", colorBrightYellow, colorBrightYellow},
-		issue.StateDone:   {"[done]", colorBrightGreen, colorBrightGreen},
-		issue.StateClosed: {"[closed]", colorGray, colorLightGray},
-	}
+	total := len(issues)
+	start, end := pageBounds(total, listOffset, listLimit)
+	issues = issues[start:end]
 
 	for _, iss := range issues {
-		style := stateStyle[iss.State]
-		labels := ""
-		if len(iss.Labels) > 0 {
-			labels = fmt.Sprintf(" [%s]", strings.Join(iss.Labels, ", "))
-		}
+		style := stateStyle(iss.State)
+		labels := formatLabels(iss.Labels)
 
 		// Reference count suffix
 		refSuffix := ""
@@ -276,20 +381,30 @@ func printIssueList(issues []*issue.Issue, skippedCount int, keyword string, ref
 		// Updated time suffix
 		dateSuffix := ""
 		if !listNoDate {
-			dateSuffix = fmt.Sprintf(" %s", colorize(formatRelativeTime(iss.UpdatedAt), colorGray))
+			dateSuffix = fmt.Sprintf(" %s", colorize(formatListTime(iss.UpdatedAt), colorGray))
 		}
+		dateSuffix += modifiedSuffix(iss.FilePath)
 
 		// Check if this is a recently closed issue
 		recentlyClosed := isRecentlyClosed(iss.UpdatedAt, string(iss.State), recentClosedDuration)
 
 		// 제목에 키워드 하이라이트 적용
 		title := highlightKeyword(iss.Title, keyword)
+		preview := previewText(iss.Body)
 
 		if recentlyClosed {
 			// Apply background color for entire row of recently closed issues
 			tag := colorizeWithBg(fmt.Sprintf("%-8s", style.tag), style.color, bgGray)
 			titlePart := colorizeWithBg(title, style.titleColor, bgGray)
-			labelsPart := colorizeWithBg(labels, "", bgGray)
+			if preview != "" {
+				titlePart += colorizeWithBg(" "+preview, colorGray, bgGray)
+			}
+			// Label chips are not colorized here so the row background stays intact
+			plainLabels := ""
+			if len(iss.Labels) > 0 {
+				plainLabels = fmt.Sprintf(" [%s]", strings.Join(iss.Labels, ", "))
+			}
+			labelsPart := colorizeWithBg(plainLabels, "", bgGray)
 			refPart := colorizeWithBg(strings.TrimPrefix(refSuffix, " "), colorGray, bgGray)
 			datePart := colorizeWithBg(strings.TrimPrefix(dateSuffix, " "), colorGray, bgGray)
 
@@ -304,49 +419,194 @@ func printIssueList(issues []*issue.Issue, skippedCount int, keyword string, ref
 			if dateSuffix != "" {
 				line += " " + datePart
 			}
-			fmt.Println(line)
+			printListLine(line)
 		} else {
 			// 상태별 밝은 색상을 제목에 적용
 			title = colorize(title, style.titleColor)
 			// 태그를 색상 적용 후 출력
 			tag := colorize(fmt.Sprintf("%-8s", style.tag), style.color)
-			fmt.Printf("%s #%-4d %s%s%s%s\n", tag, iss.Number, title, labels, refSuffix, dateSuffix)
+			printListLine(fmt.Sprintf("%s #%-4d %s%s%s%s%s", tag, iss.Number, title, previewSuffix(preview), labels, refSuffix, dateSuffix))
+		}
+	}
+
+	printListFooter(start, end, total, skippedCount)
+}
+
+// printListLine prints one list row. With --preview the row is truncated to
+// the terminal width so long previews don't wrap.
+func printListLine(line string) {
+	if listPreview > 0 {
+		line = truncateLine(line, getTerminalWidth())
+	}
+	fmt.Println(line)
+}
+
+// checkPreviewArgs rejects "--preview 10": the flag takes its value only as
+// --preview=10, so the number would otherwise be ignored silently.
+func checkPreviewArgs(args []string, previewSet bool) error {
+	if !previewSet {
+		return nil
+	}
+	for _, arg := range args {
+		if _, err := strconv.Atoi(arg); err == nil {
+			return fmt.Errorf("unexpected argument %q: use --preview=%s (a bare --preview shows %d characters)", arg, arg, defaultListPreview)
+		}
+	}
+	return nil
+}
+
+// previewText returns the body preview shown after the title, or "" when
+// --preview is off or the body has no text.
+func previewText(body string) string {
+	if listPreview <= 0 {
+		return ""
+	}
+	preview := bodyPreview(body, listPreview)
+	if preview == "" {
+		return ""
+	}
+	return glyph("—", "-") + " " + preview
+}
+
+// previewSuffix colors a preview for a normal list row.
+func previewSuffix(preview string) string {
+	if preview == "" {
+		return ""
+	}
+	return " " + colorize(preview, colorGray)
+}
+
+var (
+	mdLinkPattern   = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	mdPrefixPattern = regexp.MustCompile(`^(#{1,6}\s+|>\s*|[-*+]\s+(\[[ xX]\]\s+)?|\d+[.)]\s+)+`)
+)
+
+// bodyPreview returns the first non-empty line of body with markdown syntax
+// removed, cut to at most n characters.
+func bodyPreview(body string, n int) string {
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "```") || strings.HasPrefix(line, "<!--") {
+			continue
 		}
+
+		line = mdPrefixPattern.ReplaceAllString(line, "")
+		line = mdLinkPattern.ReplaceAllString(line, "$1")
+		line = strings.NewReplacer("**", "", "__", "", "`", "", "~~", "").Replace(line)
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if utf8.RuneCountInString(line) > n {
+			runes := []rune(line)
+			line = strings.TrimSpace(string(runes[:n])) + "…"
+		}
+		return line
 	}
+	return ""
+}
 
+// modifiedStatus returns the git status code of an issue file from
+// listModifiedFiles, or "" if it has no uncommitted changes
+func modifiedStatus(filePath string) string {
+	if listModifiedFiles == nil {
+		return ""
+	}
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return ""
+	}
+	// ModifiedFiles resolves symlinks, e.g. a project opened via a link
+	if resolved, err := filepath.EvalSymlinks(absPath); err == nil {
+		absPath = resolved
+	}
+	return listModifiedFiles[absPath]
+}
+
+// filterModified keeps issues whose files have uncommitted changes
+func filterModified(issues []*issue.Issue) []*issue.Issue {
+	var result []*issue.Issue
+	for _, iss := range issues {
+		if modifiedStatus(iss.FilePath) != "" {
+			result = append(result, iss)
+		}
+	}
+	return result
+}
+
+// modifiedSuffix marks an issue with uncommitted changes in list output
+func modifiedSuffix(filePath string) string {
+	code := modifiedStatus(filePath)
+	if code == "" {
+		return ""
+	}
+
+	status := "modified"
+	switch {
+	case code == "??":
+		status = "untracked"
+	case strings.Contains(code, "A"):
+		status = "added"
+	case strings.Contains(code, "R"):
+		status = "renamed"
+	}
+	return " " + colorize(glyph("✎ ", "* ")+status, colorYellow)
+}
+
+// formatListTime formats the updated time column: relative normally,
+// absolute in --plain mode so output is deterministic
+func formatListTime(t time.Time) string {
+	if plainOutput {
+		return t.Local().Format("2006-01-02 15:04")
+	}
+	return formatRelativeTime(t)
+}
+
+// pageBounds returns the [start, end) slice bounds for the given offset and
+// limit. A limit of 0 means no limit.
+func pageBounds(total, offset, limit int) (int, int) {
+	start := min(offset, total)
+	end := total
+	if limit > 0 {
+		end = min(start+limit, total)
+	}
+	return start, end
+}
+
+// printListFooter prints the total line, including the visible range when paginated
+func printListFooter(start, end, total, skippedCount int) {
+	skipped := ""
 	if skippedCount > 0 {
-		fmt.Printf("\nTotal: %d issues (%d skipped)\n", len(issues), skippedCount)
-	} else {
-		fmt.Printf("\nTotal: %d issues\n", len(issues))
+		skipped = fmt.Sprintf(" (%d skipped)", skippedCount)
+	}
+
+	switch {
+	case start == 0 && end == total:
+		fmt.Printf("\nTotal: %d issues%s\n", total, skipped)
+	case start == end:
+		fmt.Printf("\nShowing 0 of %d issues%s\n", total, skipped)
+	default:
+		fmt.Printf("\nShowing %d-%d of %d issues%s\n", start+1, end, total, skipped)
 	}
 }
 
 // printMultiProjectIssueList prints issues with project prefixes
 func printMultiProjectIssueList(issues []*project.ProjectIssue, skippedCount int, keyword string) {
-	// 상태별 텍스트 태그와 색상
-	stateStyle := map[issue.State]struct {
-		tag        string
-		color      string
-		titleColor string
-	}{
-		issue.StateOpen:   {"[open]", "", ""},
-		issue.StateWip:    {"[wip]", colorBrightYellow, colorBrightYellow},
-		issue.StateDone:   {"[done]", colorBrightGreen, colorBrightGreen},
-		issue.StateClosed: {"[closed]", colorGray, colorLightGray},
-	}
+	total := len(issues)
+	start, end := pageBounds(total, listOffset, listLimit)
+	issues = issues[start:end]
 
 	for _, pIss := range issues {
-		style := stateStyle[pIss.State]
-		labels := ""
-		if len(pIss.Labels) > 0 {
-			labels = fmt.Sprintf(" [%s]", strings.Join(pIss.Labels, ", "))
-		}
+		style := stateStyle(pIss.State)
+		labels := formatLabels(pIss.Labels)
 
 		// Updated time suffix
 		dateSuffix := ""
 		if !listNoDate {
-			dateSuffix = fmt.Sprintf(" %s", colorize(formatRelativeTime(pIss.UpdatedAt), colorGray))
+			dateSuffix = fmt.Sprintf(" %s", colorize(formatListTime(pIss.UpdatedAt), colorGray))
 		}
+		dateSuffix += modifiedSuffix(pIss.FilePath)
 
 		// 제목에 키워드 하이라이트 적용
 		title := highlightKeyword(pIss.Title, keyword)
@@ -357,14 +617,10 @@ func printMultiProjectIssueList(issues []*project.ProjectIssue, skippedCount int
 		tag := colorize(fmt.Sprintf("%-8s", style.tag), style.color)
 		// Use project/# format for multi-project mode
 		ref := colorize(fmt.Sprintf("%-12s", pIss.Ref()), colorCyan)
-		fmt.Printf("%s %s %s%s%s\n", tag, ref, title, labels, dateSuffix)
+		printListLine(fmt.Sprintf("%s %s %s%s%s%s", tag, ref, title, previewSuffix(previewText(pIss.Body)), labels, dateSuffix))
 	}
 
-	if skippedCount > 0 {
-		fmt.Printf("\nTotal: %d issues (%d skipped)\n", len(issues), skippedCount)
-	} else {
-		fmt.Printf("\nTotal: %d issues\n", len(issues))
-	}
+	printListFooter(start, end, total, skippedCount)
 }
 
 // filterProjectIssuesBySearch filters project issues by keyword
@@ -410,7 +666,7 @@ func filterProjectIssuesByDate(issues []*project.ProjectIssue, filter *DateFilte
 
 // printMultiProjectWarnings prints warnings with project prefix
 func printMultiProjectWarnings(warnings []project.ProjectWarning) {
-	fmt.Println(colorize(fmt.Sprintf("\n⚠️  Parse failures (%d files):", len(warnings)), colorYellow))
+	fmt.Println(colorize(fmt.Sprintf("\n%sParse failures (%d files):", glyph("⚠️  ", ""), len(warnings)), colorYellow))
 	for _, w := range warnings {
 		// Truncate filename if too long
 		name := w.FileName
@@ -429,7 +685,7 @@ func printMultiProjectWarnings(warnings []project.ProjectWarning) {
 }
 
 func printParseWarnings(warnings []issue.ParseFailure) {
-	fmt.Println(colorize(fmt.Sprintf("\n⚠️  Parse failures (%d files):", len(warnings)), colorYellow))
+	fmt.Println(colorize(fmt.Sprintf("\n%sParse failures (%d files):", glyph("⚠️  ", ""), len(warnings)), colorYellow))
 	for _, w := range warnings {
 		// Truncate filename if too long
 		name := w.FileName
@@ -487,23 +743,12 @@ func highlightKeyword(text, keyword string) string {
 }
 
 // getRecentlyClosedIssues returns done/closed issues that were updated within the given duration
-func getRecentlyClosedIssues(store *issue.Store, duration time.Duration, labelFilter, assigneeFilter string) ([]*issue.Issue, error) {
-	closedStates := []issue.State{issue.StateDone, issue.StateClosed}
-
-	var issues []*issue.Issue
-	var err error
-
-	if labelFilter != "" {
-		issues, err = store.FilterByLabel(labelFilter, closedStates...)
-	} else if assigneeFilter != "" {
-		issues, err = store.FilterByAssignee(assigneeFilter, closedStates...)
-	} else {
-		issues, err = store.List(closedStates...)
-	}
-
+func getRecentlyClosedIssues(store *issue.Store, duration time.Duration, filter issue.Filter) ([]*issue.Issue, error) {
+	issues, err := store.List(issue.TerminalStates()...)
 	if err != nil {
 		return nil, err
 	}
+	issues = filter.Apply(issues)
 
 	// Filter to only recently closed issues
 	var recentIssues []*issue.Issue
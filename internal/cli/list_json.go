@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+// IssueRecordJSON is one issue in list --format json and jsonl output.
+type IssueRecordJSON struct {
+	Project   string        `json:"project,omitempty"`
+	Number    int           `json:"number"`
+	Title     string        `json:"title"`
+	State     string        `json:"state"`
+	Labels    []string      `json:"labels"`
+	Assignees []string      `json:"assignees"`
+	Points    int           `json:"points,omitempty"`
+	CreatedAt string        `json:"created_at"`
+	UpdatedAt string        `json:"updated_at"`
+	ClosedAt  string        `json:"closed_at,omitempty"`
+	FilePath  string        `json:"file_path"`
+	Refs      *RefCountJSON `json:"refs,omitempty"`
+}
+
+// newIssueRecord converts an issue to its list record. project is empty in
+// single-project mode; refGraph is nil unless --refs is set.
+func newIssueRecord(iss *issue.Issue, project string, refGraph *issue.RefGraph) IssueRecordJSON {
+	rec := IssueRecordJSON{
+		Project:   project,
+		Number:    iss.Number,
+		Title:     iss.Title,
+		State:     string(iss.State),
+		Labels:    iss.Labels,
+		Assignees: iss.Assignees,
+		Points:    iss.Points,
+		CreatedAt: iss.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt: iss.UpdatedAt.UTC().Format(time.RFC3339),
+		FilePath:  iss.FilePath,
+	}
+	if rec.Labels == nil {
+		rec.Labels = []string{}
+	}
+	if rec.Assignees == nil {
+		rec.Assignees = []string{}
+	}
+	if iss.ClosedAt != nil {
+		rec.ClosedAt = iss.ClosedAt.UTC().Format(time.RFC3339)
+	}
+	if refGraph != nil {
+		rec.Refs = &RefCountJSON{
+			Mentions:    len(refGraph.Mentions[iss.Number]),
+			MentionedBy: len(refGraph.MentionedBy[iss.Number]),
+		}
+	}
+	return rec
+}
+
+// writeIssueRecords writes n records as a JSON array (json) or one object
+// per line (jsonl). JSON Lines output is written record by record, so
+// downstream tools can start before the list is complete.
+func writeIssueRecords(w io.Writer, format string, n int, record func(i int) IssueRecordJSON) error {
+	if format == "jsonl" {
+		enc := json.NewEncoder(w)
+		for i := 0; i < n; i++ {
+			if err := enc.Encode(record(i)); err != nil {
+				return fmt.Errorf("failed to write JSON: %w", err)
+			}
+		}
+		return nil
+	}
+
+	records := make([]IssueRecordJSON, n)
+	for i := range records {
+		records[i] = record(i)
+	}
+	out, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to format JSON: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
@@ -0,0 +1,374 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+func TestPageBounds(t *testing.T) {
+	tests := []struct {
+		name          string
+		total         int
+		offset, limit int
+		wantStart     int
+		wantEnd       int
+	}{
+		{"no pagination", 10, 0, 0, 0, 10},
+		{"limit only", 10, 0, 3, 0, 3},
+		{"offset only", 10, 4, 0, 4, 10},
+		{"offset and limit", 10, 4, 3, 4, 7},
+		{"limit past end", 10, 8, 5, 8, 10},
+		{"offset past end", 10, 20, 5, 10, 10},
+		{"empty", 0, 0, 5, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := pageBounds(tt.total, tt.offset, tt.limit)
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("pageBounds(%d, %d, %d) = (%d, %d), want (%d, %d)",
+					tt.total, tt.offset, tt.limit, start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestResolveAssignee(t *testing.T) {
+	orig := gitConfigValue
+	defer func() { gitConfigValue = orig }()
+
+	tests := []struct {
+		name    string
+		config  map[string]string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"plain name", nil, "alice", "alice", false},
+		{"empty", nil, "", "", false},
+		{"user.name", map[string]string{"user.name": "Alice", "user.email": "a@x.io"}, "@me", "Alice", false},
+		{"email fallback", map[string]string{"user.email": "a@x.io"}, "@me", "a@x.io", false},
+		{"no git user", nil, "@me", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitConfigValue = func(key string) string { return tt.config[key] }
+
+			got, err := resolveAssignee(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveAssignee(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("resolveAssignee(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBodyPreview(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		n    int
+		want string
+	}{
+		{"first non-empty line", "\n\nFirst line\nSecond", 60, "First line"},
+		{"heading marker stripped", "## Context\ntext", 60, "Context"},
+		{"list and checkbox", "- [ ] Do the thing", 60, "Do the thing"},
+		{"links and emphasis", "See **[docs](http://x)** for `details`", 60, "See docs for details"},
+		{"code fence skipped", "```go\nfmt.Println()", 60, "fmt.Println()"},
+		{"truncated", "abcdefghij", 5, "abcde…"},
+		{"unicode truncated by rune", "한국어 문장입니다", 3, "한국어…"},
+		{"empty", "\n  \n", 60, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bodyPreview(tt.body, tt.n); got != tt.want {
+				t.Errorf("bodyPreview(%q, %d) = %q, want %q", tt.body, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildIssueFilter(t *testing.T) {
+	orig := gitConfigValue
+	defer func() { gitConfigValue = orig }()
+	gitConfigValue = func(key string) string {
+		if key == "user.name" {
+			return "Alice"
+		}
+		return ""
+	}
+
+	filter, err := buildIssueFilter([]string{"bug", " ", "ui"}, []string{"@me", "bob"}, "all", false)
+	if err != nil {
+		t.Fatalf("buildIssueFilter() error = %v", err)
+	}
+	if len(filter.Labels) != 2 || !filter.MatchAll {
+		t.Errorf("filter = %+v, want 2 labels with MatchAll", filter)
+	}
+	if len(filter.Assignees) != 2 || filter.Assignees[0] != "Alice" {
+		t.Errorf("Assignees = %v, want [Alice bob]", filter.Assignees)
+	}
+
+	if _, err := buildIssueFilter(nil, nil, "some", false); err == nil {
+		t.Error("buildIssueFilter() should reject an unknown --match")
+	}
+	if _, err := buildIssueFilter(nil, []string{"bob"}, "any", true); err == nil {
+		t.Error("buildIssueFilter() should reject --unassigned with --assignee")
+	}
+
+	filter, err = addIssueExclusions(filter, []string{"wontfix", " "}, []string{"@me"})
+	if err != nil {
+		t.Fatalf("addIssueExclusions() error = %v", err)
+	}
+	if len(filter.ExcludeLabels) != 1 || filter.ExcludeLabels[0] != "wontfix" {
+		t.Errorf("ExcludeLabels = %v, want [wontfix]", filter.ExcludeLabels)
+	}
+	if len(filter.ExcludeAssignees) != 1 || filter.ExcludeAssignees[0] != "Alice" {
+		t.Errorf("ExcludeAssignees = %v, want [Alice]", filter.ExcludeAssignees)
+	}
+}
+
+func TestGetTerminalWidth(t *testing.T) {
+	orig := outputWidth
+	defer func() { outputWidth = orig }()
+
+	// Tests run without a terminal on stdout
+	outputWidth = 0
+	t.Setenv(EnvWidth, "")
+	if got := getTerminalWidth(); got != defaultOutputWidth {
+		t.Errorf("without settings: got %d, want %d", got, defaultOutputWidth)
+	}
+
+	t.Setenv(EnvWidth, "72")
+	if got := getTerminalWidth(); got != 72 {
+		t.Errorf("%s=72: got %d", EnvWidth, got)
+	}
+
+	t.Setenv(EnvWidth, "wide")
+	if got := getTerminalWidth(); got != defaultOutputWidth {
+		t.Errorf("invalid %s: got %d, want %d", EnvWidth, got, defaultOutputWidth)
+	}
+
+	outputWidth = 50
+	t.Setenv(EnvWidth, "72")
+	if got := getTerminalWidth(); got != 50 {
+		t.Errorf("--width overrides %s: got %d", EnvWidth, got)
+	}
+}
+
+func TestWriteIssueRecords(t *testing.T) {
+	records := []IssueRecordJSON{
+		newIssueRecord(&issue.Issue{Number: 1, Title: "First", State: issue.StateOpen}, "", nil),
+		newIssueRecord(&issue.Issue{Number: 2, Title: "Second", State: issue.StateDone, Labels: []string{"bug"}}, "", nil),
+	}
+	record := func(i int) IssueRecordJSON { return records[i] }
+
+	tests := []struct {
+		name   string
+		format string
+		n      int
+		want   string
+	}{
+		{"jsonl empty", "jsonl", 0, ""},
+		{"json empty", "json", 0, "[]\n"},
+		{"jsonl one per line", "jsonl", 2,
+			`{"number":1,"title":"First","state":"open","labels":[],"assignees":[],"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z","file_path":""}` + "\n" +
+				`{"number":2,"title":"Second","state":"done","labels":["bug"],"assignees":[],"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z","file_path":""}` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := writeIssueRecords(&buf, tt.format, tt.n, record); err != nil {
+				t.Fatalf("writeIssueRecords() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("writeIssueRecords() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("json array", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writeIssueRecords(&buf, "json", 2, record); err != nil {
+			t.Fatalf("writeIssueRecords() error = %v", err)
+		}
+		var got []IssueRecordJSON
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("output is not a JSON array: %v", err)
+		}
+		if len(got) != 2 || got[1].Number != 2 {
+			t.Errorf("got %+v, want 2 records", got)
+		}
+	})
+}
+
+func TestStatePriority(t *testing.T) {
+	order := func() []issue.State {
+		states := issue.AllStates()
+		sort.SliceStable(states, func(i, j int) bool {
+			return statePriority(states[i]) < statePriority(states[j])
+		})
+		return states
+	}
+
+	if got, want := order(), []issue.State{issue.StateDone, issue.StateClosed, issue.StateWip, issue.StateOpen}; !slices.Equal(got, want) {
+		t.Errorf("default order = %v, want %v", got, want)
+	}
+
+	t.Cleanup(func() { issue.SetStates(nil) })
+	issue.SetStates(issue.StateSet{
+		{Name: issue.StateOpen, Active: true},
+		{Name: issue.StateWip, Active: true},
+		{Name: "review", Active: true},
+		{Name: issue.StateDone, Terminal: true},
+		{Name: issue.StateClosed, Terminal: true},
+	})
+	if got, want := order(), []issue.State{issue.StateDone, issue.StateClosed, "review", issue.StateWip, issue.StateOpen}; !slices.Equal(got, want) {
+		t.Errorf("configured order = %v, want %v", got, want)
+	}
+	if statePriority("unknown") != 5 {
+		t.Errorf("unknown state should sort last, got %d", statePriority("unknown"))
+	}
+}
+
+func TestModifiedStatusThroughSymlink(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "project")
+	if err := os.MkdirAll(filepath.Join(target, ".issues"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(target, ".issues", "001-a.md")
+	if err := os.WriteFile(file, []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(t.TempDir(), "link")
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	resolved, err := filepath.EvalSymlinks(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listModifiedFiles = map[string]string{resolved: " M"}
+	t.Cleanup(func() { listModifiedFiles = nil })
+
+	if got := modifiedStatus(filepath.Join(link, ".issues", "001-a.md")); got != " M" {
+		t.Errorf("modifiedStatus() via symlink = %q, want %q", got, " M")
+	}
+}
+
+func TestCheckPreviewArgs(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		previewSet bool
+		wantErr    bool
+	}{
+		{"no preview", []string{"10"}, false, false},
+		{"bare preview", nil, true, false},
+		{"separated value", []string{"10"}, true, true},
+		{"other argument", []string{"open"}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkPreviewArgs(tt.args, tt.previewSet); (err != nil) != tt.wantErr {
+				t.Errorf("checkPreviewArgs() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestListCountOnly(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := []struct {
+		state, labels string
+	}{
+		{"open", "[bug]"},
+		{"open", "[]"},
+		{"wip", "[bug]"},
+		{"done", "[bug]"},
+		{"closed", "[]"},
+	}
+	for i, f := range files {
+		content := fmt.Sprintf("---\nnumber: %d\ntitle: Issue\nstate: %s\nlabels: %s\ncreated_at: 2024-01-01T00:00:00Z\nupdated_at: 2024-01-01T00:00:00Z\n---\n", i+1, f.state, f.labels)
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("%03d-issue.md", i+1)), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		state  string
+		all    bool
+		labels []string
+		want   string
+	}{
+		{"active", "", false, nil, "3"},
+		{"all", "", true, nil, "5"},
+		{"state", "open", false, nil, "2"},
+		{"label", "", false, []string{"bug"}, "2"},
+		{"state and label", "done", false, []string{"bug"}, "1"},
+		{"no match", "closed", false, []string{"bug"}, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listCountOnly, listState, listAll, listLabels = true, tt.state, tt.all, tt.labels
+			t.Cleanup(func() { listCountOnly, listState, listAll, listLabels = false, "", false, nil })
+
+			cmd := &cobra.Command{}
+			cmd.Flags().StringArray("project", nil, "")
+			cmd.Flags().String("dir", ".issues", "")
+			if err := cmd.Flags().Set("dir", dir); err != nil {
+				t.Fatal(err)
+			}
+
+			out := captureStdout(t, func() error { return runList(cmd, nil) })
+			if got := strings.TrimSpace(out); got != tt.want {
+				t.Errorf("count = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// captureStdout returns what fn prints to stdout.
+func captureStdout(t *testing.T, fn func() error) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := os.Stdout
+	os.Stdout = w
+	fnErr := fn()
+	os.Stdout = saved
+	w.Close()
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		t.Fatal(err)
+	}
+	if fnErr != nil {
+		t.Fatal(fnErr)
+	}
+	return buf.String()
+}
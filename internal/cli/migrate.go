@@ -19,13 +19,24 @@ This command will:
 2. Move files using git mv (falls back to mv if not git-tracked)
 3. Remove empty state directories
 
-After migration, state is determined solely from frontmatter.`,
+After migration, state is determined solely from frontmatter.
+
+With --to-legacy, the reverse is done for tools that still expect the
+directory layout: flat files are moved into open/, wip/, done/ and closed/
+based on their frontmatter state. This is refused if state directories
+already contain issues.
+
+Examples:
+  zap migrate --dry-run              # Preview the flat migration
+  zap migrate --to-legacy --dry-run  # Preview moving issues into state directories
+  zap migrate --to-legacy -y         # Move issues into state directories`,
 	RunE: runMigrate,
 }
 
 var (
 	migrateDryRun bool
 	migrateYes    bool
+	migrateLegacy bool
 )
 
 func init() {
@@ -33,6 +44,7 @@ func init() {
 
 	migrateCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false, "Show what would be migrated without making changes")
 	migrateCmd.Flags().BoolVarP(&migrateYes, "yes", "y", false, "Skip confirmation prompt")
+	migrateCmd.Flags().BoolVar(&migrateLegacy, "to-legacy", false, "Move flat issues into state directories (reverse migration)")
 }
 
 func runMigrate(cmd *cobra.Command, args []string) error {
@@ -60,6 +72,10 @@ func runMigrate(cmd *cobra.Command, args []string) error {
 
 	store := issue.NewStore(dir)
 
+	if migrateLegacy {
+		return runMigrateToLegacy(store)
+	}
+
 	// Detect legacy structure
 	info, err := store.DetectLegacyStructure()
 	if err != nil {
@@ -103,6 +119,66 @@ func runMigrate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	printMigrateResult(result)
+	return nil
+}
+
+// runMigrateToLegacy moves flat issues into state directories.
+func runMigrateToLegacy(store *issue.Store) error {
+	info, err := store.DetectFlatStructure()
+	if err != nil {
+		return err
+	}
+
+	if info.HasLegacyStructure {
+		return fmt.Errorf("state directories already contain issues; run 'zap migrate' first to avoid mixing flat and legacy structure")
+	}
+	if info.TotalIssues == 0 {
+		fmt.Println("No flat issues found. Nothing to migrate.")
+		return nil
+	}
+
+	fmt.Printf("Found %d issues in flat structure:\n\n", info.TotalIssues)
+	for _, state := range issue.AllStates() {
+		files := info.IssuesByState[state]
+		if len(files) > 0 {
+			fmt.Printf("  -> %s/ (%d files)\n", state, len(files))
+			for _, f := range files {
+				fmt.Printf("    - %s\n", f)
+			}
+		}
+	}
+	if len(info.Unparsed) > 0 {
+		fmt.Printf("\n  %s %d files could not be parsed and will stay in place:\n", glyph("⚠️ ", "!"), len(info.Unparsed))
+		for _, f := range info.Unparsed {
+			fmt.Printf("    - %s\n", f)
+		}
+	}
+
+	if migrateDryRun {
+		fmt.Println("\nDry run complete. No changes made.")
+		return nil
+	}
+
+	if !migrateYes {
+		fmt.Println()
+		if !confirm("Migrate to legacy directory structure?") {
+			fmt.Println("Migration cancelled.")
+			return nil
+		}
+	}
+
+	result, err := store.MigrateToLegacy()
+	if err != nil {
+		return err
+	}
+
+	printMigrateResult(result)
+	return nil
+}
+
+// printMigrateResult prints the summary of a migration.
+func printMigrateResult(result *issue.MigrateResult) {
 	fmt.Printf("\nMigration complete:\n")
 	fmt.Printf("  Migrated: %d\n", result.Migrated)
 	if result.Failed > 0 {
@@ -111,6 +187,4 @@ func runMigrate(cmd *cobra.Command, args []string) error {
 			fmt.Printf("    - %s: %s\n", f, result.Errors[i])
 		}
 	}
-
-	return nil
 }
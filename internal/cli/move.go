@@ -1,38 +1,81 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/itda-work/zap/internal/issue"
-	"github.com/itda-work/zap/internal/project"
 	"github.com/spf13/cobra"
 )
 
-var setCmd = &cobra.Command{
-	Use:   "set <state> <number>",
-	Short: "Set issue state (open, wip, done, closed)",
-	Long: `Set issue state to one of: open, wip, done, closed.
+// setLongFormat is the Long help of set; %s is the list of states.
+const setLongFormat = `Set issue state to one of: %s.
+States can be added in the states section of .zap.yml.
+Without a number in a terminal, the issue is picked interactively.
 
 Examples:
   zap set done 1
+  zap set done               # Pick the issue interactively
   zap set wip 5
   zap set open 2
-  zap set closed 3`,
-	Args:              cobra.ExactArgs(2),
+  zap set closed 3
+  zap set done 4 --dry-run   # Preview the resulting frontmatter
+  zap set done 4 --porcelain # Print "4<TAB>wip<TAB>done<TAB>path" for scripts
+  zap set closed 5 --comment "duplicate of #3"
+  zap set --all-done-to-closed --older-than 14d  # Archive issues done for two weeks
+
+With --porcelain, a single tab-separated line is printed:
+number, old state, new state and file path. An issue already in the
+target state prints the same state twice.
+
+With --comment, the reason is appended with a timestamp to the Comments
+section of the issue body. It is only recorded when the state changes.
+
+With --all-done-to-closed, every done issue is closed after confirmation.
+--older-than limits this to issues done for at least the given duration
+(e.g. 72h, 14d); --dry-run only lists them.`
+
+var setCmd = &cobra.Command{
+	Use:   "set <state> [number]",
+	Short: "Set issue state (" + issue.ValidStates() + ")",
+	Long:  fmt.Sprintf(setLongFormat, issue.ValidStates()),
+	Args: func(cmd *cobra.Command, args []string) error {
+		if setAllDoneToClosed {
+			return cobra.NoArgs(cmd, args)
+		}
+		return issueArgs(2)(cmd, args)
+	},
 	ValidArgsFunction: completeSetArgs,
 	RunE:              runSetCmd,
 }
 
 var (
-	setProject string
+	setProject   string
+	setForce     bool
+	setDryRun    bool
+	setPorcelain bool
+	setComment   string
+
+	setAllDoneToClosed bool
+	setOlderThan       string
+	setYes             bool
 )
 
 func init() {
 	rootCmd.AddCommand(setCmd)
-	setCmd.Flags().StringVarP(&setProject, "project", "p", "", "Project alias (for multi-project mode)")
+	setCmd.Flags().StringVarP(&setProject, "alias", "p", "", "Project alias (for multi-project mode)")
+	setCmd.Flags().BoolVarP(&setForce, "force", "f", false, "Ignore the workflow policy in .zap.yml")
+	setCmd.Flags().BoolVar(&setDryRun, "dry-run", false, "Print the resulting file content without writing it")
+	setCmd.Flags().BoolVar(&setPorcelain, "porcelain", false, "Print a stable tab-separated line: number, old state, new state, path")
+	setCmd.Flags().StringVar(&setComment, "comment", "", "Record why the state changed in the issue's Comments section")
+	setCmd.Flags().BoolVar(&setAllDoneToClosed, "all-done-to-closed", false, "Close every done issue")
+	setCmd.Flags().StringVar(&setOlderThan, "older-than", "", "With --all-done-to-closed, only issues done for at least this long (e.g. 72h, 14d)")
+	setCmd.Flags().BoolVarP(&setYes, "yes", "y", false, "With --all-done-to-closed, skip the confirmation prompt")
 }
 
 // completeSetArgs provides completion for the set command
@@ -67,10 +110,26 @@ func completeSetArgs(cmd *cobra.Command, args []string, toComplete string) ([]st
 }
 
 func runSetCmd(cmd *cobra.Command, args []string) error {
+	if setAllDoneToClosed {
+		return runCloseAllDone(cmd)
+	}
+	if setOlderThan != "" {
+		return fmt.Errorf("--older-than requires --all-done-to-closed")
+	}
+
 	stateStr := args[0]
 	targetState, ok := issue.ParseState(stateStr)
 	if !ok {
-		return fmt.Errorf("invalid state: %s (valid: open, wip, done, closed)", stateStr)
+		return fmt.Errorf("invalid state: %s (valid: %s)", stateStr, issue.ValidStates())
+	}
+
+	if setPorcelain && setDryRun {
+		return fmt.Errorf("--porcelain cannot be used with --dry-run")
+	}
+
+	args, err := pickIssueArg(cmd, args, 2, targetState)
+	if err != nil {
+		return err
 	}
 
 	// Check for multi-project mode
@@ -84,51 +143,151 @@ func runSetCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid issue number: %s", args[1])
 	}
 
-	// Get issues directory with discovery info
-	dir, wasDiscovered, err := getIssuesDirWithDiscovery(cmd)
+	dir, store, err := openSetStore(cmd)
 	if err != nil {
 		return err
 	}
 
-	// If discovered from parent directory
+	iss, err := store.Get(number)
+	if err != nil {
+		return err
+	}
+
+	if iss.State == targetState {
+		if setPorcelain {
+			printPorcelain(number, iss.State, targetState, iss.FilePath)
+			return nil
+		}
+		fmt.Printf("Issue #%d is already in %s state.\n", number, targetState)
+		return nil
+	}
+
+	oldState := iss.State
+
+	if setDryRun {
+		preview := *iss
+		if strings.TrimSpace(setComment) != "" {
+			preview.AddComment(transitionComment(oldState, targetState), time.Now())
+		}
+		return printSetPreview(store, &preview, targetState)
+	}
+
+	undo := newUndoRecorder(dir, fmt.Sprintf("set %s %d", targetState, number))
+	if err := transitionIssue(store, undo, iss, targetState); err != nil {
+		return err
+	}
+	undo.saveOrWarn()
+
+	if setPorcelain {
+		printPorcelain(number, oldState, targetState, movedPath(store, iss))
+		return nil
+	}
+
+	fmt.Printf("Issue #%d: %s → %s\n", number, oldState, targetState)
+	printTransitionTip(targetState)
+	return nil
+}
+
+// openSetStore returns the issues directory and store for set. A directory
+// found in a parent is only used after confirmation in a terminal, and the
+// workflow of .zap.yml applies unless --force is given.
+func openSetStore(cmd *cobra.Command) (string, *issue.Store, error) {
+	dir, wasDiscovered, err := getIssuesDirWithDiscovery(cmd)
+	if err != nil {
+		return "", nil, err
+	}
 	if wasDiscovered {
-		// Show info message
 		fmt.Fprintf(os.Stderr, "info: Using .issues at %s\n", dir)
-
-		// Check if TTY
 		if !IsTTY() {
-			return fmt.Errorf("cannot modify issues in parent directory from non-interactive session (use --project or -d flag to specify directory explicitly)")
+			return "", nil, fmt.Errorf("cannot modify issues in parent directory from non-interactive session (use --project or -d flag to specify directory explicitly)")
 		}
-
-		// Confirm with user
 		if !confirmYesDefault("Proceed with this .issues directory?") {
-			return fmt.Errorf("operation cancelled")
+			return "", nil, fmt.Errorf("operation cancelled")
 		}
 	}
 
 	store := issue.NewStore(dir)
+	if !setForce {
+		if err := applyWorkflow(store); err != nil {
+			return "", nil, err
+		}
+	}
+	return dir, store, nil
+}
 
-	iss, err := store.Get(number)
+// transitionIssue moves iss to state and adds the --comment reason. The
+// files it changes are tracked in undo first; the caller saves the log.
+func transitionIssue(store *issue.Store, undo *undoRecorder, iss *issue.Issue, state issue.State) error {
+	undo.track(iss.FilePath)
+	if filepath.Dir(iss.FilePath) != store.BaseDir() {
+		// Legacy structure: the file moves to the new state directory
+		undo.track(filepath.Join(store.BaseDir(), issue.StateDir(state), filepath.Base(iss.FilePath)))
+	}
+
+	if err := store.Move(iss.Number, state); err != nil {
+		return moveError(err)
+	}
+	return addTransitionComment(store, iss.Number, iss.State, state)
+}
+
+// movedPath returns the file path of iss after transitionIssue, which
+// differs from iss.FilePath in the legacy structure.
+func movedPath(store *issue.Store, iss *issue.Issue) string {
+	if moved, err := store.Get(iss.Number); err == nil {
+		return moved.FilePath
+	}
+	return iss.FilePath
+}
+
+// printSetPreview prints the file content 'zap set' would write, without writing it
+func printSetPreview(store *issue.Store, iss *issue.Issue, targetState issue.State) error {
+	data, err := store.PreviewState(iss, targetState)
 	if err != nil {
-		return err
+		return moveError(err)
 	}
 
-	if iss.State == targetState {
-		fmt.Printf("Issue #%d is already in %s state.\n", number, targetState)
+	if filepath.Dir(iss.FilePath) != store.BaseDir() {
+		// Legacy structure: Move renames the file into the state directory
+		newPath := filepath.Join(store.BaseDir(), issue.StateDir(targetState), filepath.Base(iss.FilePath))
+		fmt.Printf("Would move %s → %s\n", iss.FilePath, newPath)
 		return nil
 	}
 
-	oldState := iss.State
+	fmt.Printf("Would write %s:\n\n%s", iss.FilePath, data)
+	return nil
+}
 
-	if err := store.Move(number, targetState); err != nil {
-		return fmt.Errorf("failed to move issue: %w", err)
-	}
+// transitionComment is the --comment text recorded for a state change.
+func transitionComment(from, to issue.State) string {
+	return fmt.Sprintf("%s → %s: %s", from, to, setComment)
+}
 
-	fmt.Printf("Issue #%d: %s → %s\n", number, oldState, targetState)
-	printTransitionTip(targetState)
+// addTransitionComment appends the --comment reason to an issue that just
+// changed state. It does nothing without --comment.
+func addTransitionComment(store *issue.Store, number int, from, to issue.State) error {
+	if strings.TrimSpace(setComment) == "" {
+		return nil
+	}
+	iss, err := store.Get(number)
+	if err != nil {
+		return err
+	}
+	iss.AddComment(transitionComment(from, to), time.Now())
+	if err := store.Save(iss); err != nil {
+		return fmt.Errorf("failed to save comment: %w", err)
+	}
 	return nil
 }
 
+// moveError wraps a Move error, pointing at --force for workflow violations
+func moveError(err error) error {
+	var transitionErr *issue.TransitionError
+	if errors.As(err, &transitionErr) {
+		return fmt.Errorf("%w (use --force to override)", err)
+	}
+	return fmt.Errorf("failed to move issue: %w", err)
+}
+
 // printTransitionTip prints a helpful tip after state transition
 func printTransitionTip(state issue.State) {
 	var tip string
@@ -150,67 +309,53 @@ func runMultiProjectMove(cmd *cobra.Command, args []string, targetState issue.St
 		return err
 	}
 
-	// Parse the issue argument - could be "project/#number" or just "number"
-	arg := args[0]
+	pIss, err := multiStore.Resolve(args[0], setProject)
+	if err != nil {
+		return err
+	}
 
-	var projectAlias string
-	var number int
+	if pIss.State == targetState {
+		if setPorcelain {
+			printPorcelain(pIss.Ref(), pIss.State, targetState, pIss.FilePath)
+			return nil
+		}
+		fmt.Printf("%s is already in %s state.\n", pIss.Ref(), targetState)
+		return nil
+	}
+
+	oldState := pIss.State
+	proj, _ := multiStore.GetProject(pIss.Project)
 
-	// Check if it's a project reference (e.g., "zap/#1")
-	if project.IsProjectRef(arg) {
-		ref, err := project.ParseRef(arg)
-		if err != nil {
+	if !setForce {
+		if err := applyWorkflow(proj.Store); err != nil {
 			return err
 		}
-		projectAlias = ref.Project
-		number = ref.Number
-	} else {
-		// It's just a number - need to find it
-		var err error
-		number, err = strconv.Atoi(arg)
-		if err != nil {
-			return fmt.Errorf("invalid issue reference: %s (expected: number or project/#number)", arg)
-		}
+	}
 
-		// If --project flag is specified, use it
-		projectFlag, _ := cmd.Flags().GetString("project")
-		if projectFlag != "" {
-			projectAlias = projectFlag
-		} else {
-			// Search across all projects
-			matches := multiStore.FindByNumber(number)
-			if len(matches) == 0 {
-				return fmt.Errorf("issue #%d not found in any project", number)
-			}
-			if len(matches) > 1 {
-				// Ambiguous - show all matches
-				fmt.Fprintf(os.Stderr, "Issue #%d exists in multiple projects:\n", number)
-				for _, m := range matches {
-					fmt.Fprintf(os.Stderr, "  - %s (%s)\n", m.Ref(), m.Title)
-				}
-				return fmt.Errorf("please specify project with --project or use project/#number format")
-			}
-			projectAlias = matches[0].Project
+	if setDryRun {
+		preview := *pIss.Issue
+		if strings.TrimSpace(setComment) != "" {
+			preview.AddComment(transitionComment(oldState, targetState), time.Now())
 		}
+		return printSetPreview(proj.Store, &preview, targetState)
 	}
 
-	// Get the issue to check current state
-	pIss, err := multiStore.Get(projectAlias, number)
-	if err != nil {
+	if err := multiStore.Move(pIss.Project, pIss.Number, targetState); err != nil {
+		return moveError(err)
+	}
+	if err := addTransitionComment(proj.Store, pIss.Number, oldState, targetState); err != nil {
 		return err
 	}
 
-	if pIss.State == targetState {
-		fmt.Printf("%s is already in %s state.\n", pIss.Ref(), targetState)
+	if setPorcelain {
+		path := pIss.FilePath
+		if moved, err := multiStore.Resolve(args[0], setProject); err == nil {
+			path = moved.FilePath
+		}
+		printPorcelain(pIss.Ref(), oldState, targetState, path)
 		return nil
 	}
 
-	oldState := pIss.State
-
-	if err := multiStore.Move(projectAlias, number, targetState); err != nil {
-		return fmt.Errorf("failed to move issue: %w", err)
-	}
-
 	fmt.Printf("%s: %s → %s\n", pIss.Ref(), oldState, targetState)
 	printTransitionTip(targetState)
 	return nil
@@ -89,16 +89,6 @@ func runMoveProject(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err := os.MkdirAll(dstDir, 0755); err != nil {
-		return fmt.Errorf("failed to create destination issues directory: %w", err)
-	}
-
-	dstStore := issue.NewStore(dstDir)
-	nextNumber, err := findNextIssueNumber(dstStore)
-	if err != nil {
-		return fmt.Errorf("failed to determine next issue number in destination: %w", err)
-	}
-
 	now := time.Now().UTC()
 	srcProjectName := filepath.Base(filepath.Dir(absSrcDir))
 	provenanceNote := fmt.Sprintf("> Moved from %s #%d", srcProjectName, srcIssue.Number)
@@ -111,7 +101,6 @@ func runMoveProject(cmd *cobra.Command, args []string) error {
 	}
 
 	dstIssue := &issue.Issue{
-		Number:    nextNumber,
 		Title:     srcIssue.Title,
 		State:     srcIssue.State,
 		Labels:    srcIssue.Labels,
@@ -122,18 +111,11 @@ func runMoveProject(cmd *cobra.Command, args []string) error {
 		Body:      body,
 	}
 
-	slug := generateSlug(srcIssue.Title)
-	filename := fmt.Sprintf("%03d-%s.md", nextNumber, slug)
-	dstFilePath := filepath.Join(dstDir, filename)
-
-	data, err := issue.Serialize(dstIssue)
+	nextNumber, err := issue.NewStore(dstDir).Create(dstIssue)
 	if err != nil {
-		return fmt.Errorf("failed to serialize issue: %w", err)
-	}
-
-	if err := os.WriteFile(dstFilePath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write issue file: %w", err)
+		return fmt.Errorf("failed to create issue in destination: %w", err)
 	}
+	filename := filepath.Base(dstIssue.FilePath)
 
 	dstName := filepath.Base(dstProjectPath)
 	if moveDelete {
@@ -90,22 +90,11 @@ updated_at: 2026-01-01T00:00:00Z
 		t.Fatalf("failed to create dest issue: %v", err)
 	}
 
-	dstStore := issue.NewStore(dstIssuesDir)
-	nextNumber, err := findNextIssueNumber(dstStore)
-	if err != nil {
-		t.Fatalf("findNextIssueNumber failed: %v", err)
-	}
-
-	if nextNumber != 2 {
-		t.Fatalf("expected next number 2, got %d", nextNumber)
-	}
-
 	srcProjectName := filepath.Base(srcDir)
 	provenanceNote := "> Moved from " + srcProjectName + " #3"
 	body := provenanceNote + "\n\n" + srcIssue.Body
 
 	dstIssue := &issue.Issue{
-		Number:    nextNumber,
 		Title:     srcIssue.Title,
 		State:     srcIssue.State,
 		Labels:    srcIssue.Labels,
@@ -116,17 +105,17 @@ updated_at: 2026-01-01T00:00:00Z
 		Body:      body,
 	}
 
-	slug := generateSlug(srcIssue.Title)
-	filename := "002-" + slug + ".md"
-	dstFilePath := filepath.Join(dstIssuesDir, filename)
-
-	data, err := issue.Serialize(dstIssue)
+	nextNumber, err := issue.NewStore(dstIssuesDir).Create(dstIssue)
 	if err != nil {
-		t.Fatalf("serialize failed: %v", err)
+		t.Fatalf("Create failed: %v", err)
+	}
+	if nextNumber != 2 {
+		t.Fatalf("expected next number 2, got %d", nextNumber)
 	}
 
-	if err := os.WriteFile(dstFilePath, data, 0644); err != nil {
-		t.Fatalf("write failed: %v", err)
+	dstFilePath := filepath.Join(dstIssuesDir, "002-fix-login-bug.md")
+	if dstIssue.FilePath != dstFilePath {
+		t.Errorf("destination path = %q, want %q", dstIssue.FilePath, dstFilePath)
 	}
 
 	parsedDst, err := issue.Parse(dstFilePath)
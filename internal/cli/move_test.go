@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+func TestMultiProjectMoveDryRun(t *testing.T) {
+	root := t.TempDir()
+	var projects []string
+	for _, name := range []string{"a", "b"} {
+		dir := filepath.Join(root, name, ".issues")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		content := "---\nnumber: 1\ntitle: Issue\nstate: open\nlabels: []\nassignees: []\ncreated_at: 2026-01-01T00:00:00Z\nupdated_at: 2026-01-01T00:00:00Z\n---\n"
+		if err := os.WriteFile(filepath.Join(dir, "001-issue.md"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		projects = append(projects, filepath.Join(root, name))
+	}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringArray("project", projects, "")
+	cmd.Flags().String("dir", ".issues", "")
+
+	setDryRun = true
+	t.Cleanup(func() { setDryRun = false })
+
+	if err := runMultiProjectMove(cmd, []string{"a/#1"}, issue.StateDone); err != nil {
+		t.Fatalf("runMultiProjectMove() = %v", err)
+	}
+
+	iss, err := issue.NewStore(filepath.Join(root, "a", ".issues")).Get(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iss.State != issue.StateOpen {
+		t.Errorf("dry run changed the state to %s", iss.State)
+	}
+}
@@ -2,24 +2,22 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"regexp"
-	"strconv"
 	"strings"
 	"time"
-	"unicode"
 
+	"github.com/itda-work/zap/internal/ai"
 	"github.com/itda-work/zap/internal/issue"
 	"github.com/itda-work/zap/internal/project"
 	"github.com/spf13/cobra"
-	"golang.org/x/text/unicode/norm"
 )
 
 var newCmd = &cobra.Command{
-	Use:   "new <title>",
+	Use:   "new [title]",
 	Short: "Create a new issue with proper format",
 	Long: `Create a new issue file with the correct frontmatter format.
 
@@ -32,8 +30,20 @@ Examples:
   zap new "Refactor database layer" -a alice -a bob
   zap new "Update docs" --body "Need to update API documentation"
   echo "Issue description" | zap new "New feature"
-  zap new "Complex issue" --editor`,
-	Args: cobra.ExactArgs(1),
+  zap new "Complex issue" --editor
+  zap new "Add CSV export" --ai-body
+  zap new "Try it out" --dry-run
+  zap new "Fix flaky test" --start        # Create as wip, assigned to you
+  zap new --from-file notes/idea.md       # Title from the leading # heading
+  zap new "Standup {{date}}" --from-file templates/standup.md
+  zap new "Fix login bug" --porcelain     # Print "<number><TAB><path>" for scripts
+  zap new "Restore old issue" --number 12 # Use #12 instead of the next number
+
+Placeholders in the title and in a --from-file body are filled in when the
+issue is created: {{date}}, {{week}}, {{month}}, {{year}}, {{author}} (git
+user) and, in the body, {{title}}. Bodies given with --body, on stdin or
+drafted by AI are kept as written. Unknown placeholders are left as they are.`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runNew,
 }
 
@@ -44,36 +54,108 @@ var (
 	newEditor    bool
 	newState     string
 	newProject   string
+	newAIBody    bool
+	newAITimeout time.Duration
+	newDryRun    bool
+	newFromFile  string
+	newStart     bool
+	newPorcelain bool
+	newNumber    int
 )
 
 func init() {
 	rootCmd.AddCommand(newCmd)
 
 	newCmd.Flags().StringArrayVarP(&newLabels, "label", "l", nil, "Add label (can be used multiple times)")
-	newCmd.Flags().StringArrayVarP(&newAssignees, "assignee", "a", nil, "Add assignee (can be used multiple times)")
+	newCmd.Flags().StringArrayVarP(&newAssignees, "assignee", "a", nil, "Add assignee, @me for your git user (can be used multiple times)")
+	_ = newCmd.RegisterFlagCompletionFunc("label", completeLabels)
+	_ = newCmd.RegisterFlagCompletionFunc("assignee", completeAssignees)
 	newCmd.Flags().StringVarP(&newBody, "body", "b", "", "Issue body content")
 	newCmd.Flags().BoolVarP(&newEditor, "editor", "e", false, "Open editor to write issue body")
-	newCmd.Flags().StringVarP(&newState, "state", "s", "open", "Initial state (open, wip, done, closed)")
-	newCmd.Flags().StringVarP(&newProject, "project", "p", "", "Project alias (required for multi-project mode)")
+	newCmd.Flags().StringVarP(&newState, "state", "s", "open", "Initial state ("+issue.ValidStates()+")")
+	newCmd.Flags().StringVarP(&newProject, "alias", "p", "", "Project alias (required for multi-project mode)")
+	newCmd.Flags().BoolVar(&newAIBody, "ai-body", false, "Draft the issue body with AI from the title")
+	newCmd.Flags().DurationVar(&newAITimeout, "ai-timeout", 60*time.Second, "AI request timeout for --ai-body")
+	newCmd.Flags().BoolVar(&newDryRun, "dry-run", false, "Print the file that would be created without writing it")
+	newCmd.Flags().StringVar(&newFromFile, "from-file", "", "Import a markdown file (leading # heading becomes the title)")
+	newCmd.Flags().BoolVar(&newStart, "start", false, "Create the issue as wip, assigned to you unless -a is given")
+	newCmd.Flags().BoolVar(&newPorcelain, "porcelain", false, "Print a stable tab-separated line: number, path")
+	newCmd.Flags().IntVar(&newNumber, "number", 0, "Create the issue with this number (fails if it is already used)")
 }
 
 func runNew(cmd *cobra.Command, args []string) error {
-	title := strings.TrimSpace(args[0])
+	if newPorcelain && newDryRun {
+		return fmt.Errorf("--porcelain cannot be used with --dry-run")
+	}
+	if cmd.Flags().Changed("number") && newNumber <= 0 {
+		return fmt.Errorf("--number must be positive")
+	}
+
+	var title string
+	templateBody := false
+	if len(args) > 0 {
+		title = strings.TrimSpace(args[0])
+	}
+
+	// Import title and body from a markdown file
+	if newFromFile != "" {
+		data, err := os.ReadFile(newFromFile)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", newFromFile, err)
+		}
+		fileTitle, fileBody := parseMarkdownNote(string(data))
+		if len(args) == 0 {
+			if fileTitle == "" {
+				return fmt.Errorf("no title: pass one or start %s with a # heading", newFromFile)
+			}
+			title = fileTitle
+		} else if fileTitle != "" {
+			// Keep the heading when an explicit title replaces it
+			fileBody = strings.TrimSpace("# " + fileTitle + "\n\n" + fileBody)
+		}
+		if newBody == "" {
+			newBody = fileBody
+			templateBody = true
+		}
+	} else if len(args) == 0 {
+		return fmt.Errorf("requires a title (or --from-file)")
+	}
+
 	if title == "" {
 		return fmt.Errorf("title cannot be empty")
 	}
+	title = expandPlaceholders(title, time.Now(), "")
+	if templateBody {
+		newBody = expandPlaceholders(newBody, time.Now(), title)
+	}
+
+	if newStart {
+		var err error
+		newState, newAssignees, err = startIssueDefaults(newState, cmd.Flags().Changed("state"), newAssignees)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Validate state
 	state, ok := issue.ParseState(newState)
 	if !ok {
-		return fmt.Errorf("invalid state: %s (valid: open, wip, done, closed)", newState)
+		return fmt.Errorf("invalid state: %s (valid: %s)", newState, issue.ValidStates())
+	}
+
+	for i, a := range newAssignees {
+		resolved, err := resolveAssignee(a)
+		if err != nil {
+			return err
+		}
+		newAssignees[i] = resolved
 	}
 
 	// Check for multi-project mode
 	if isMultiProjectMode(cmd) {
 		// Multi-project mode requires --project flag
 		if newProject == "" {
-			return fmt.Errorf("--project flag is required when using multiple -C flags")
+			return fmt.Errorf("--alias (-p) flag is required when using multiple -C flags")
 		}
 
 		multiStore, err := getMultiStore(cmd)
@@ -114,16 +196,10 @@ func runNew(cmd *cobra.Command, args []string) error {
 	}
 
 	// Ensure issues directory exists
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create issues directory: %w", err)
-	}
-
-	store := issue.NewStore(dir)
-
-	// Find next issue number
-	nextNumber, err := findNextIssueNumber(store)
-	if err != nil {
-		return fmt.Errorf("failed to determine next issue number: %w", err)
+	if !newDryRun {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create issues directory: %w", err)
+		}
 	}
 
 	// Determine body content
@@ -144,6 +220,11 @@ func runNew(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Draft body with AI if requested
+	if newAIBody && body == "" {
+		body = draftIssueBody(dir, title)
+	}
+
 	// Open editor if requested
 	if newEditor {
 		editedBody, err := openEditor(body)
@@ -153,142 +234,124 @@ func runNew(cmd *cobra.Command, args []string) error {
 		body = editedBody
 	}
 
-	// Create issue struct
-	now := time.Now().UTC()
 	iss := &issue.Issue{
-		Number:    nextNumber,
+		Number:    newNumber,
 		Title:     title,
 		State:     state,
 		Labels:    newLabels,
 		Assignees: newAssignees,
-		CreatedAt: now,
-		UpdatedAt: now,
 		Body:      strings.TrimSpace(body),
 	}
 
-	// Generate filename
-	slug := generateSlug(title)
-	filename := fmt.Sprintf("%03d-%s.md", nextNumber, slug)
-	filePath := filepath.Join(dir, filename)
-
-	// Serialize issue
-	data, err := issue.Serialize(iss)
-	if err != nil {
-		return fmt.Errorf("failed to serialize issue: %w", err)
+	if newDryRun {
+		return printNewIssuePreview(dir, iss)
 	}
 
-	// Write file
-	if err := os.WriteFile(filePath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write issue file: %w", err)
+	if _, err := issue.NewStore(dir).Create(iss); err != nil {
+		return err
 	}
 
-	fmt.Printf("✅ Created issue #%d: %s\n", nextNumber, filename)
+	if newPorcelain {
+		printPorcelain(iss.Number, iss.FilePath)
+		return nil
+	}
+	fmt.Printf("✅ Created issue #%d: %s\n", iss.Number, filepath.Base(iss.FilePath))
 	return nil
 }
 
-// findNextIssueNumber finds the next available issue number.
-// It considers both successfully parsed issues and parse failures.
-func findNextIssueNumber(store *issue.Store) (int, error) {
-	// Load all issues (this also populates warnings)
-	issues, err := store.List(issue.AllStates()...)
-	if err != nil {
-		return 0, err
+// startIssueDefaults applies --start: the state becomes wip and, if no
+// assignees were given, the issue is assigned to the current git user.
+// If the git user is unknown the issue is created unassigned with a warning.
+func startIssueDefaults(state string, stateChanged bool, assignees []string) (string, []string, error) {
+	if parsed, _ := issue.ParseState(state); stateChanged && parsed != issue.StateWip {
+		return "", nil, fmt.Errorf("--start conflicts with --state %s", state)
 	}
+	if len(assignees) == 0 {
+		if me, err := resolveMe(); err == nil {
+			assignees = []string{me}
+		} else {
+			fmt.Fprintf(os.Stderr, "⚠️  %v (creating unassigned)\n", err)
+		}
+	}
+	return string(issue.StateWip), assignees, nil
+}
 
-	maxNumber := 0
-
-	// Check parsed issues
-	for _, iss := range issues {
-		if iss.Number > maxNumber {
-			maxNumber = iss.Number
+// parseMarkdownNote splits a markdown note into a title and body.
+// Any frontmatter is dropped. If the first non-empty line is a "# Heading",
+// it becomes the title and the rest is the body; otherwise the title is empty.
+func parseMarkdownNote(content string) (title, body string) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	lines := strings.Split(content, "\n")
+
+	// Strip frontmatter
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == "---" {
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) == "---" {
+				lines = lines[i+1:]
+				break
+			}
 		}
 	}
 
-	// Check parse failures (extract number from filename)
-	for _, w := range store.Warnings() {
-		if num := extractNumberFromFilename(w.FileName); num > maxNumber {
-			maxNumber = num
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
 		}
+		if strings.HasPrefix(trimmed, "# ") {
+			title = strings.TrimSpace(strings.TrimPrefix(trimmed, "# "))
+			lines = lines[i+1:]
+		}
+		break
 	}
 
-	return maxNumber + 1, nil
+	return title, strings.TrimSpace(strings.Join(lines, "\n"))
 }
 
-// extractNumberFromFilename extracts the issue number from a filename.
-// Supports formats: "NNN-title.md", "N-title.md", etc.
-func extractNumberFromFilename(filename string) int {
-	// Remove .md extension
-	name := strings.TrimSuffix(filename, ".md")
-
-	// Find the first hyphen
-	idx := strings.Index(name, "-")
-	if idx == -1 {
-		return 0
-	}
-
-	// Try to parse the number part
-	numStr := name[:idx]
-	num, err := strconv.Atoi(numStr)
+// draftIssueBody asks the AI client to draft an issue body from the title.
+// Returns an empty body (with a warning) if AI is unavailable or fails.
+func draftIssueBody(dir, title string) string {
+	client, err := getAIClient(dir, "", "")
 	if err != nil {
-		return 0
+		fmt.Fprintf(os.Stderr, "⚠️  %v (creating issue without body)\n", err)
+		return ""
 	}
 
-	return num
-}
+	tmpl, ok := ai.GetTemplate("draft-issue-body")
+	if !ok {
+		fmt.Fprintf(os.Stderr, "⚠️  draft-issue-body template not found (creating issue without body)\n")
+		return ""
+	}
 
-// generateSlug creates a URL-friendly slug from the title.
-// Supports Korean and other Unicode characters.
-func generateSlug(title string) string {
-	// Normalize Unicode (NFC)
-	title = norm.NFC.String(title)
-
-	// Convert to lowercase
-	title = strings.ToLower(title)
-
-	// Remove common prefixes like "feat:", "fix:", "docs:", etc.
-	prefixPattern := regexp.MustCompile(`^(feat|fix|docs|chore|refactor|test|style|perf|ci|build):\s*`)
-	title = prefixPattern.ReplaceAllString(title, "")
-
-	// Replace spaces and underscores with hyphens
-	title = strings.ReplaceAll(title, " ", "-")
-	title = strings.ReplaceAll(title, "_", "-")
-
-	// Keep only alphanumeric, Korean, and hyphens
-	var result strings.Builder
-	prevHyphen := false
-	for _, r := range title {
-		if unicode.IsLetter(r) || unicode.IsDigit(r) {
-			result.WriteRune(r)
-			prevHyphen = false
-		} else if r == '-' && !prevHyphen && result.Len() > 0 {
-			result.WriteRune('-')
-			prevHyphen = true
-		}
+	req, err := tmpl.Render(map[string]string{"title": title})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Failed to render prompt: %v\n", err)
+		return ""
 	}
 
-	slug := result.String()
+	fmt.Fprintf(os.Stderr, "🤖 Drafting issue body with %s...\n", client.Name())
 
-	// Remove trailing hyphen
-	slug = strings.TrimSuffix(slug, "-")
+	ctx, cancel := context.WithTimeout(context.Background(), newAITimeout)
+	defer cancel()
 
-	// Limit length to 50 characters (cut at word boundary if possible)
-	if len(slug) > 50 {
-		// Try to cut at a hyphen boundary
-		truncated := slug[:50]
-		lastHyphen := strings.LastIndex(truncated, "-")
-		if lastHyphen > 30 {
-			slug = truncated[:lastHyphen]
-		} else {
-			slug = truncated
-		}
+	resp, err := client.Complete(ctx, req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  AI draft failed: %v (creating issue without body)\n", err)
+		return ""
 	}
 
-	// Ensure slug is not empty
-	if slug == "" {
-		slug = "issue"
+	return cleanAIResponse(resp.Content)
+}
+
+// printNewIssuePreview prints the file that 'zap new' would create without writing it.
+func printNewIssuePreview(dir string, iss *issue.Issue) error {
+	filename, data, err := issue.NewStore(dir).PreviewCreate(iss)
+	if err != nil {
+		return err
 	}
 
-	return slug
+	fmt.Printf("Would create %s:\n\n%s", filepath.Join(dir, filename), data)
+	return nil
 }
 
 // openEditor opens the user's preferred editor for writing the issue body.
@@ -344,16 +407,10 @@ func createIssueInProject(proj *project.Project, issuesDir string, title string,
 	dir := proj.IssuesDir(issuesDir)
 
 	// Ensure issues directory exists
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create issues directory: %w", err)
-	}
-
-	store := issue.NewStore(dir)
-
-	// Find next issue number
-	nextNumber, err := findNextIssueNumber(store)
-	if err != nil {
-		return fmt.Errorf("failed to determine next issue number: %w", err)
+	if !newDryRun {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create issues directory: %w", err)
+		}
 	}
 
 	// Determine body content
@@ -381,35 +438,27 @@ func createIssueInProject(proj *project.Project, issuesDir string, title string,
 		body = editedBody
 	}
 
-	// Create issue struct
-	now := time.Now().UTC()
 	iss := &issue.Issue{
-		Number:    nextNumber,
+		Number:    newNumber,
 		Title:     title,
 		State:     state,
 		Labels:    newLabels,
 		Assignees: newAssignees,
-		CreatedAt: now,
-		UpdatedAt: now,
 		Body:      strings.TrimSpace(body),
 	}
 
-	// Generate filename
-	slug := generateSlug(title)
-	filename := fmt.Sprintf("%03d-%s.md", nextNumber, slug)
-	filePath := filepath.Join(dir, filename)
-
-	// Serialize issue
-	data, err := issue.Serialize(iss)
-	if err != nil {
-		return fmt.Errorf("failed to serialize issue: %w", err)
+	if newDryRun {
+		return printNewIssuePreview(dir, iss)
 	}
 
-	// Write file
-	if err := os.WriteFile(filePath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write issue file: %w", err)
+	if _, err := issue.NewStore(dir).Create(iss); err != nil {
+		return err
 	}
 
-	fmt.Printf("✅ Created %s/#%d: %s\n", proj.Alias, nextNumber, filename)
+	if newPorcelain {
+		printPorcelain(fmt.Sprintf("%s/#%d", proj.Alias, iss.Number), iss.FilePath)
+		return nil
+	}
+	fmt.Printf("✅ Created %s/#%d: %s\n", proj.Alias, iss.Number, filepath.Base(iss.FilePath))
 	return nil
 }
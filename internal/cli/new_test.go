@@ -1,285 +1,150 @@
 package cli
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
 )
 
-func TestGenerateSlug(t *testing.T) {
+func TestParseMarkdownNote(t *testing.T) {
 	tests := []struct {
-		name     string
-		title    string
-		expected string
+		name      string
+		content   string
+		wantTitle string
+		wantBody  string
 	}{
-		{
-			name:     "simple english",
-			title:    "Fix login bug",
-			expected: "fix-login-bug",
-		},
-		{
-			name:     "with prefix feat:",
-			title:    "feat: Add user authentication",
-			expected: "add-user-authentication",
-		},
-		{
-			name:     "with prefix fix:",
-			title:    "fix: Handle null pointer",
-			expected: "handle-null-pointer",
-		},
-		{
-			name:     "korean only",
-			title:    "사용자 인증 추가",
-			expected: "사용자-인증-추가",
-		},
-		{
-			name:     "mixed korean and english",
-			title:    "User 인증 기능 추가",
-			expected: "user-인증-기능-추가",
-		},
-		{
-			name:     "special characters",
-			title:    "Fix bug #123: Handle @mention",
-			expected: "fix-bug-123-handle-mention",
-		},
-		{
-			name:     "multiple spaces",
-			title:    "Fix   multiple   spaces",
-			expected: "fix-multiple-spaces",
-		},
-		{
-			name:     "underscores",
-			title:    "fix_underscore_title",
-			expected: "fix-underscore-title",
-		},
-		{
-			name:     "long title truncation",
-			title:    "This is a very long title that should be truncated at fifty characters boundary",
-			expected: "this-is-a-very-long-title-that-should-be",
-		},
-		{
-			name:     "empty after processing",
-			title:    "!@#$%",
-			expected: "issue",
-		},
-		{
-			name:     "numbers",
-			title:    "Update API v2 endpoints",
-			expected: "update-api-v2-endpoints",
-		},
-		{
-			name:     "leading special chars",
-			title:    "## Fix header bug",
-			expected: "fix-header-bug",
-		},
+		{"heading and body", "# Add CSV export\n\nUsers want CSV.\n", "Add CSV export", "Users want CSV."},
+		{"leading blank lines", "\n\n# Title\nBody", "Title", "Body"},
+		{"no heading", "Just some notes\n\n# Later heading", "", "Just some notes\n\n# Later heading"},
+		{"subheading is not a title", "## Notes\nText", "", "## Notes\nText"},
+		{"frontmatter stripped", "---\nnumber: 3\ntitle: Old\n---\n# New title\nBody", "New title", "Body"},
+		{"crlf", "# Title\r\nLine one\r\nLine two\r\n", "Title", "Line one\nLine two"},
+		{"empty", "", "", ""},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := generateSlug(tt.title)
-			if result != tt.expected {
-				t.Errorf("generateSlug(%q) = %q, want %q", tt.title, result, tt.expected)
+			title, body := parseMarkdownNote(tt.content)
+			if title != tt.wantTitle || body != tt.wantBody {
+				t.Errorf("parseMarkdownNote() = (%q, %q), want (%q, %q)", title, body, tt.wantTitle, tt.wantBody)
 			}
 		})
 	}
 }
 
-func TestExtractNumberFromFilename(t *testing.T) {
+func TestStartIssueDefaults(t *testing.T) {
+	orig := gitConfigValue
+	defer func() { gitConfigValue = orig }()
+
 	tests := []struct {
-		name     string
-		filename string
-		expected int
+		name          string
+		state         string
+		stateChanged  bool
+		assignees     []string
+		gitUser       string
+		wantAssignees []string
+		wantErr       bool
 	}{
-		{
-			name:     "three digit padded",
-			filename: "001-feat-login.md",
-			expected: 1,
-		},
-		{
-			name:     "two digit",
-			filename: "24-fix-bug.md",
-			expected: 24,
-		},
-		{
-			name:     "large number",
-			filename: "999-final-issue.md",
-			expected: 999,
-		},
-		{
-			name:     "no hyphen",
-			filename: "readme.md",
-			expected: 0,
-		},
-		{
-			name:     "non-numeric prefix",
-			filename: "abc-title.md",
-			expected: 0,
-		},
-		{
-			name:     "empty filename",
-			filename: "",
-			expected: 0,
-		},
-		{
-			name:     "just number",
-			filename: "123-.md",
-			expected: 123,
-		},
+		{"defaults to me", "open", false, nil, "alice", []string{"alice"}, false},
+		{"keeps given assignees", "open", false, []string{"bob"}, "alice", []string{"bob"}, false},
+		{"unknown git user", "open", false, nil, "", nil, false},
+		{"explicit wip state", "wip", true, nil, "alice", []string{"alice"}, false},
+		{"wip state alias", "in-progress", true, nil, "alice", []string{"alice"}, false},
+		{"conflicting state", "done", true, nil, "alice", nil, true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := extractNumberFromFilename(tt.filename)
-			if result != tt.expected {
-				t.Errorf("extractNumberFromFilename(%q) = %d, want %d", tt.filename, result, tt.expected)
+			gitConfigValue = func(key string) string {
+				if key == "user.name" {
+					return tt.gitUser
+				}
+				return ""
+			}
+
+			state, assignees, err := startIssueDefaults(tt.state, tt.stateChanged, tt.assignees)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if state != "wip" {
+				t.Errorf("state = %q, want wip", state)
+			}
+			if fmt.Sprint(assignees) != fmt.Sprint(tt.wantAssignees) {
+				t.Errorf("assignees = %v, want %v", assignees, tt.wantAssignees)
 			}
 		})
 	}
 }
 
-func TestFindNextIssueNumber(t *testing.T) {
+func TestNewExpandsOnlyTemplateBodies(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, ".issues")
+	template := filepath.Join(root, "template.md")
+	if err := os.WriteFile(template, []byte("Notes for {{title}}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []struct {
 		name     string
-		files    map[string]string // filename -> content
-		expected int
+		body     string
+		fromFile string
+		want     string
 	}{
-		{
-			name:     "empty directory",
-			files:    map[string]string{},
-			expected: 1,
-		},
-		{
-			name: "sequential issues",
-			files: map[string]string{
-				"001-first.md": `---
-number: 1
-title: "First"
-state: open
----`,
-				"002-second.md": `---
-number: 2
-title: "Second"
-state: open
----`,
-			},
-			expected: 3,
-		},
-		{
-			name: "with gap",
-			files: map[string]string{
-				"001-first.md": `---
-number: 1
-title: "First"
-state: open
----`,
-				"005-fifth.md": `---
-number: 5
-title: "Fifth"
-state: open
----`,
-			},
-			expected: 6,
-		},
-		{
-			name: "with parse failure",
-			files: map[string]string{
-				"001-first.md": `---
-number: 1
-title: "First"
-state: open
----`,
-				"003-broken.md": `invalid content without frontmatter`,
-			},
-			expected: 4,
-		},
+		{"body flag kept as written", "Use {{title}} in templates", "", "Use {{title}} in templates"},
+		{"template expanded", "", template, "Notes for Sync"},
 	}
 
-	for _, tt := range tests {
+	for i, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Create temp directory
-			tmpDir, err := os.MkdirTemp("", "zap-test-*")
-			if err != nil {
-				t.Fatalf("Failed to create temp dir: %v", err)
+			newBody, newFromFile = tt.body, tt.fromFile
+			t.Cleanup(func() { newBody, newFromFile = "", "" })
+
+			cmd := &cobra.Command{}
+			cmd.Flags().StringArray("project", nil, "")
+			cmd.Flags().String("dir", ".issues", "")
+			if err := cmd.Flags().Set("dir", dir); err != nil {
+				t.Fatal(err)
 			}
-			defer os.RemoveAll(tmpDir)
 
-			// Create test files
-			for filename, content := range tt.files {
-				filePath := filepath.Join(tmpDir, filename)
-				if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
-					t.Fatalf("Failed to create test file: %v", err)
-				}
+			if err := runNew(cmd, []string{"Sync"}); err != nil {
+				t.Fatalf("runNew() = %v", err)
 			}
-
-			// Test
-			store := issue.NewStore(tmpDir)
-			result, err := findNextIssueNumber(store)
+			iss, err := issue.NewStore(dir).Get(i + 1)
 			if err != nil {
-				t.Fatalf("findNextIssueNumber failed: %v", err)
+				t.Fatal(err)
 			}
-
-			if result != tt.expected {
-				t.Errorf("findNextIssueNumber() = %d, want %d", result, tt.expected)
+			if iss.Body != tt.want {
+				t.Errorf("body = %q, want %q", iss.Body, tt.want)
 			}
 		})
 	}
 }
 
-func TestNewCommandIntegration(t *testing.T) {
-	// Create temp directory
-	tmpDir, err := os.MkdirTemp("", "zap-new-test-*")
-	if err != nil {
-		t.Fatalf("Failed to create temp dir: %v", err)
-	}
-	defer os.RemoveAll(tmpDir)
-
-	issuesDir := filepath.Join(tmpDir, ".issues")
-	if err := os.MkdirAll(issuesDir, 0755); err != nil {
-		t.Fatalf("Failed to create issues dir: %v", err)
-	}
-
-	// Create an existing issue
-	existingIssue := `---
-number: 1
-title: "Existing issue"
-state: open
-labels: []
-assignees: []
-created_at: 2026-01-17T00:00:00Z
-updated_at: 2026-01-17T00:00:00Z
----
-`
-	if err := os.WriteFile(filepath.Join(issuesDir, "001-existing-issue.md"), []byte(existingIssue), 0644); err != nil {
-		t.Fatalf("Failed to create existing issue: %v", err)
-	}
-
-	// Test finding next number
-	store := issue.NewStore(issuesDir)
-	nextNum, err := findNextIssueNumber(store)
-	if err != nil {
-		t.Fatalf("findNextIssueNumber failed: %v", err)
-	}
+func TestNewNumberWithoutIssuesDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	newNumber, newDryRun = 5, true
+	t.Cleanup(func() { newNumber, newDryRun = 0, false })
 
-	if nextNum != 2 {
-		t.Errorf("Expected next number to be 2, got %d", nextNum)
-	}
-
-	// Test slug generation for various titles
-	slugTests := []struct {
-		title    string
-		expected string
-	}{
-		{"New Feature", "new-feature"},
-		{"버그 수정", "버그-수정"},
-		{"feat: Add login", "add-login"},
+	cmd := &cobra.Command{}
+	cmd.Flags().StringArray("project", nil, "")
+	cmd.Flags().String("dir", ".issues", "")
+	if err := cmd.Flags().Set("dir", dir); err != nil {
+		t.Fatal(err)
 	}
 
-	for _, st := range slugTests {
-		slug := generateSlug(st.title)
-		if slug != st.expected {
-			t.Errorf("generateSlug(%q) = %q, want %q", st.title, slug, st.expected)
-		}
+	out := captureStdout(t, func() error { return runNew(cmd, []string{"First"}) })
+	if !strings.Contains(out, "number: 5") {
+		t.Errorf("dry run output does not use number 5:\n%s", out)
 	}
 }
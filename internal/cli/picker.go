@@ -0,0 +1,255 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// pickerHeight is the number of issues shown at once in the picker.
+const pickerHeight = 10
+
+// errPickerCancelled is returned when the picker is closed without a choice.
+var errPickerCancelled = errors.New("no issue selected")
+
+// canPick reports whether the interactive issue picker can be used.
+func canPick() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stderr.Fd()))
+}
+
+// issueArgs is like cobra.ExactArgs(n), but allows the trailing issue number
+// to be left out in a terminal, where it is picked interactively.
+func issueArgs(n int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) == n-1 && canPick() {
+			return nil
+		}
+		return cobra.ExactArgs(n)(cmd, args)
+	}
+}
+
+// pickIssueArg returns args with the issue number appended if it was left
+// out, letting the user choose among issues not in excludeStates.
+func pickIssueArg(cmd *cobra.Command, args []string, n int, excludeStates ...issue.State) ([]string, error) {
+	if len(args) >= n {
+		return args, nil
+	}
+	if isMultiProjectMode(cmd) {
+		return nil, fmt.Errorf("issue number required in multi-project mode")
+	}
+
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return nil, err
+	}
+	issues, err := issue.NewStore(dir).List(issue.AllStates()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+
+	excluded := make(map[issue.State]bool)
+	for _, s := range excludeStates {
+		excluded[s] = true
+	}
+	var candidates []*issue.Issue
+	for _, iss := range issues {
+		if !excluded[iss.State] {
+			candidates = append(candidates, iss)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no issues to choose from")
+	}
+	sortIssuesByStateAndTime(candidates)
+
+	picked, err := pickIssue(candidates)
+	if err != nil {
+		return nil, err
+	}
+	return append(args, strconv.Itoa(picked.Number)), nil
+}
+
+// pickerLabel is the text shown and matched for an issue in the picker.
+func pickerLabel(iss *issue.Issue) string {
+	return fmt.Sprintf("#%-4d %-7s %s", iss.Number, "["+string(iss.State)+"]", iss.Title)
+}
+
+// fuzzyMatch reports whether all runes of query appear in text in order,
+// ignoring case. An empty query matches everything.
+func fuzzyMatch(query, text string) bool {
+	text = strings.ToLower(text)
+	for _, q := range strings.ToLower(query) {
+		if unicode.IsSpace(q) {
+			continue
+		}
+		i := strings.IndexRune(text, q)
+		if i < 0 {
+			return false
+		}
+		text = text[i+utf8.RuneLen(q):]
+	}
+	return true
+}
+
+// filterPicker returns the issues whose picker label fuzzy-matches query.
+func filterPicker(issues []*issue.Issue, query string) []*issue.Issue {
+	var matches []*issue.Issue
+	for _, iss := range issues {
+		if fuzzyMatch(query, pickerLabel(iss)) {
+			matches = append(matches, iss)
+		}
+	}
+	return matches
+}
+
+// pickIssue shows a fuzzy-filtered list on stderr and returns the chosen
+// issue. Type to filter, ↑/↓ (or Ctrl-P/Ctrl-N) to move, Enter to select,
+// Esc or Ctrl-C to cancel.
+func pickIssue(issues []*issue.Issue) (*issue.Issue, error) {
+	fd := int(os.Stdin.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start picker: %w", err)
+	}
+	defer term.Restore(fd, state)
+
+	query := ""
+	cursor := 0
+	drawn := 0
+	matches := issues
+	width := getTerminalWidth()
+	out := os.Stderr
+
+	draw := func() {
+		if drawn > 0 {
+			fmt.Fprintf(out, "\033[%dA", drawn)
+		}
+		fmt.Fprint(out, "\r\033[J")
+
+		fmt.Fprintf(out, "%s %s\r\n", colorize("Issue>", colorCyan), query)
+		lines := 1
+
+		start := 0
+		if cursor >= pickerHeight {
+			start = cursor - pickerHeight + 1
+		}
+		for i := start; i < len(matches) && i < start+pickerHeight; i++ {
+			line := "  " + pickerLabel(matches[i])
+			if i == cursor {
+				line = colorize(glyph("›", ">")+" "+pickerLabel(matches[i]), colorBrightYellow)
+			}
+			fmt.Fprint(out, truncateLine(line, width)+"\r\n")
+			lines++
+		}
+		fmt.Fprint(out, colorize(fmt.Sprintf("  %d/%d", len(matches), len(issues)), colorGray))
+		drawn = lines
+	}
+
+	clear := func() {
+		fmt.Fprintf(out, "\033[%dA\r\033[J", drawn)
+	}
+
+	buf := make([]byte, 64)
+	for {
+		draw()
+
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			clear()
+			return nil, err
+		}
+
+		for _, key := range pickerKeys(buf[:n]) {
+			switch key {
+			case keyCancel:
+				clear()
+				return nil, errPickerCancelled
+			case keyEnter:
+				clear()
+				if len(matches) == 0 {
+					return nil, errPickerCancelled
+				}
+				return matches[cursor], nil
+			case keyUp:
+				if cursor > 0 {
+					cursor--
+				}
+			case keyDown:
+				if cursor < len(matches)-1 {
+					cursor++
+				}
+			case keyBackspace:
+				if query != "" {
+					_, size := utf8.DecodeLastRuneInString(query)
+					query = query[:len(query)-size]
+					matches = filterPicker(issues, query)
+					cursor = 0
+				}
+			default:
+				query += key
+				matches = filterPicker(issues, query)
+				cursor = 0
+			}
+		}
+	}
+}
+
+// Special picker keys; any other key is text typed into the query.
+const (
+	keyCancel    = "\x00cancel"
+	keyEnter     = "\x00enter"
+	keyUp        = "\x00up"
+	keyDown      = "\x00down"
+	keyBackspace = "\x00backspace"
+)
+
+// pickerKeys splits raw terminal input into keys. One read can hold several
+// keys when typing fast or pasting. Unknown escape sequences are dropped.
+func pickerKeys(input []byte) []string {
+	var keys []string
+	for len(input) > 0 {
+		switch b := input[0]; {
+		case b == 27 && len(input) >= 3 && (input[1] == '[' || input[1] == 'O'):
+			switch input[2] {
+			case 'A':
+				keys = append(keys, keyUp)
+			case 'B':
+				keys = append(keys, keyDown)
+			}
+			// Skip the rest of the sequence up to its final byte
+			end := 2
+			for end < len(input) && (input[end] < 0x40 || input[end] > 0x7e) {
+				end++
+			}
+			input = input[min(end+1, len(input)):]
+			continue
+		case b == 27 || b == 3: // Esc, Ctrl-C
+			keys = append(keys, keyCancel)
+		case b == '\r' || b == '\n':
+			keys = append(keys, keyEnter)
+		case b == 16: // Ctrl-P
+			keys = append(keys, keyUp)
+		case b == 14: // Ctrl-N
+			keys = append(keys, keyDown)
+		case b == 127 || b == 8:
+			keys = append(keys, keyBackspace)
+		case b >= 32:
+			r, size := utf8.DecodeRune(input)
+			if r != utf8.RuneError {
+				keys = append(keys, string(r))
+			}
+			input = input[size:]
+			continue
+		}
+		input = input[1:]
+	}
+	return keys
+}
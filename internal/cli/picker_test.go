@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		query string
+		text  string
+		want  bool
+	}{
+		{"", "#1 [open] Anything", true},
+		{"lgn", "#3 [wip] Fix login", true},
+		{"LOGIN", "#3 [wip] Fix login", true},
+		{"fix log", "#3 [wip] Fix login", true},
+		{"#3", "#3 [wip] Fix login", true},
+		{"nigol", "#3 [wip] Fix login", false},
+		{"로그", "#4 [open] 로그인 수정", true},
+	}
+
+	for _, tt := range tests {
+		if got := fuzzyMatch(tt.query, tt.text); got != tt.want {
+			t.Errorf("fuzzyMatch(%q, %q) = %v, want %v", tt.query, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestPickerKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"typed text", "ab", []string{"a", "b"}},
+		{"text then enter", "t5\r", []string{"t", "5", keyEnter}},
+		{"arrows", "\x1b[A\x1b[B\x1bOB", []string{keyUp, keyDown, keyDown}},
+		{"esc alone", "\x1b", []string{keyCancel}},
+		{"ctrl keys", "\x10\x0e\x03", []string{keyUp, keyDown, keyCancel}},
+		{"backspace", "x\x7f", []string{"x", keyBackspace}},
+		{"unknown sequence dropped", "\x1b[1;5Cq", []string{"q"}},
+		{"unicode", "한", []string{"한"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pickerKeys([]byte(tt.input)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pickerKeys(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// placeholderPattern matches a {{name}} token in an issue title or body
+var placeholderPattern = regexp.MustCompile(`\{\{(\w+)\}\}`)
+
+// expandPlaceholders replaces tokens in an issue title or body:
+//
+//	{{date}}, {{week}}, {{month}}, {{year}}  from t
+//	{{author}}                               the git user (user.name, then user.email)
+//	{{title}}                                title, if not empty
+//
+// Unknown tokens, and tokens without a value, are left as they are.
+func expandPlaceholders(text string, t time.Time, title string) string {
+	if !placeholderPattern.MatchString(text) {
+		return text
+	}
+
+	year, week := t.ISOWeek()
+	values := map[string]string{
+		"date":  t.Format("2006-01-02"),
+		"week":  fmt.Sprintf("%d-W%02d", year, week),
+		"month": t.Format("2006-01"),
+		"year":  t.Format("2006"),
+		"title": title,
+	}
+
+	return placeholderPattern.ReplaceAllStringFunc(text, func(token string) string {
+		name := placeholderPattern.FindStringSubmatch(token)[1]
+		if name == "author" {
+			if me, err := resolveMe(); err == nil {
+				return me
+			}
+			return token
+		}
+		if v := values[name]; v != "" {
+			return v
+		}
+		return token
+	})
+}
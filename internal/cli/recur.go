@@ -0,0 +1,338 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// recurringFileName is the schedule file inside the issues directory.
+const recurringFileName = ".recurring.yml"
+
+var recurCmd = &cobra.Command{
+	Use:   "recur",
+	Short: "Manage recurring issues",
+	Long: `Manage issues that are created automatically on a schedule.
+
+Recurring issues are defined in .issues/.recurring.yml:
+
+  recurring:
+    - title: "Weekly sync {{date}}"
+      schedule: weekly:mon
+      labels: [meeting]
+    - title: "Dependency review {{month}}"
+      schedule: monthly:1
+      assignees: [alice]
+
+Schedules:
+  daily                Every day
+  weekly[:<weekday>]   Every week (default: monday)
+  monthly[:<day>]      Every month (default: 1st)
+  every:<duration>     Fixed interval (e.g., every:36h, every:10d)
+
+Title and body placeholders: {{date}}, {{week}}, {{month}}, {{year}},
+{{author}} (git user) and, in the body, {{title}}`,
+}
+
+var recurRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Create recurring issues that are due",
+	Long: `Create every recurring issue whose schedule is due and record when it was created.
+
+Run this from cron or CI to keep recurring issues coming. The last_created
+times are written back to .recurring.yml, which drops comments in the file.
+
+Examples:
+  zap recur run             # Create due issues
+  zap recur run --dry-run   # Show what would be created`,
+	Args: cobra.NoArgs,
+	RunE: runRecurRun,
+}
+
+var recurDryRun bool
+
+func init() {
+	rootCmd.AddCommand(recurCmd)
+	recurCmd.AddCommand(recurRunCmd)
+	recurRunCmd.Flags().BoolVar(&recurDryRun, "dry-run", false, "Show what would be created without making changes")
+}
+
+// recurringFile is the on-disk format of .recurring.yml.
+type recurringFile struct {
+	Recurring []*recurringEntry `yaml:"recurring"`
+}
+
+// recurringEntry describes one recurring issue.
+type recurringEntry struct {
+	Title       string    `yaml:"title"`
+	Schedule    string    `yaml:"schedule"`
+	State       string    `yaml:"state,omitempty"`
+	Labels      []string  `yaml:"labels,omitempty"`
+	Assignees   []string  `yaml:"assignees,omitempty"`
+	Body        string    `yaml:"body,omitempty"`
+	LastCreated time.Time `yaml:"last_created,omitempty"`
+}
+
+// recurSchedule is a parsed schedule expression.
+type recurSchedule struct {
+	kind     string // "daily", "weekly", "monthly", "every"
+	weekday  time.Weekday
+	day      int
+	interval time.Duration
+}
+
+func runRecurRun(cmd *cobra.Command, args []string) error {
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, recurringFileName)
+	rf, err := loadRecurringFile(path)
+	if err != nil {
+		return err
+	}
+
+	if len(rf.Recurring) == 0 {
+		fmt.Printf("No recurring issues defined in %s\n", path)
+		return nil
+	}
+
+	store := issue.NewStore(dir)
+	now := time.Now()
+	created := 0
+
+	for _, entry := range rf.Recurring {
+		sched, err := parseRecurSchedule(entry.Schedule)
+		if err != nil {
+			return fmt.Errorf("recurring issue %q: %w", entry.Title, err)
+		}
+
+		if !sched.due(entry.LastCreated, now) {
+			continue
+		}
+
+		iss, err := entry.issue(now)
+		if err != nil {
+			return err
+		}
+
+		if recurDryRun {
+			fmt.Printf("Would create: %s (%s)\n", iss.Title, entry.Schedule)
+			created++
+			continue
+		}
+
+		if _, err := store.Create(iss); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Created issue #%d: %s\n", iss.Number, filepath.Base(iss.FilePath))
+
+		// Saved right away so a later failure cannot create this issue twice
+		entry.LastCreated = now.UTC().Truncate(time.Second)
+		if err := saveRecurringFile(path, rf); err != nil {
+			return err
+		}
+		created++
+	}
+
+	if created == 0 {
+		fmt.Println("No recurring issues are due.")
+		return nil
+	}
+
+	if recurDryRun {
+		fmt.Printf("\n%d issue(s) would be created (dry run)\n", created)
+	}
+	return nil
+}
+
+// loadRecurringFile reads the recurring issue definitions.
+// A missing file yields an empty definition list.
+func loadRecurringFile(path string) (*recurringFile, error) {
+	rf := &recurringFile{}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return rf, nil
+		}
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, rf); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	for i, entry := range rf.Recurring {
+		if entry == nil || strings.TrimSpace(entry.Title) == "" {
+			return nil, fmt.Errorf("%s: entry %d has no title", path, i+1)
+		}
+	}
+
+	return rf, nil
+}
+
+// saveRecurringFile writes the recurring issue definitions back to disk.
+// The file is re-marshalled, so comments and formatting in it are lost.
+func saveRecurringFile(path string, rf *recurringFile) error {
+	data, err := yaml.Marshal(rf)
+	if err != nil {
+		return fmt.Errorf("failed to serialize %s: %w", path, err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// issue builds the issue to create for this entry at the given time.
+func (e *recurringEntry) issue(now time.Time) (*issue.Issue, error) {
+	state := issue.StateOpen
+	if e.State != "" {
+		s, ok := issue.ParseState(e.State)
+		if !ok {
+			return nil, fmt.Errorf("recurring issue %q: invalid state %q", e.Title, e.State)
+		}
+		state = s
+	}
+
+	title := expandPlaceholders(e.Title, now, "")
+	return &issue.Issue{
+		Title:     title,
+		State:     state,
+		Labels:    e.Labels,
+		Assignees: e.Assignees,
+		Body:      strings.TrimSpace(expandPlaceholders(e.Body, now, title)),
+	}, nil
+}
+
+// parseRecurSchedule parses a schedule expression such as "weekly:fri".
+func parseRecurSchedule(s string) (*recurSchedule, error) {
+	kind, arg, _ := strings.Cut(strings.ToLower(strings.TrimSpace(s)), ":")
+
+	switch kind {
+	case "daily":
+		return &recurSchedule{kind: kind}, nil
+
+	case "weekly":
+		sched := &recurSchedule{kind: kind, weekday: time.Monday}
+		if arg != "" {
+			wd, ok := parseWeekday(arg)
+			if !ok {
+				return nil, fmt.Errorf("invalid weekday %q in schedule %q", arg, s)
+			}
+			sched.weekday = wd
+		}
+		return sched, nil
+
+	case "monthly":
+		sched := &recurSchedule{kind: kind, day: 1}
+		if arg != "" {
+			day, err := strconv.Atoi(arg)
+			if err != nil || day < 1 || day > 31 {
+				return nil, fmt.Errorf("invalid day %q in schedule %q", arg, s)
+			}
+			sched.day = day
+		}
+		return sched, nil
+
+	case "every":
+		d, err := parseRecurInterval(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval in schedule %q: %w", s, err)
+		}
+		return &recurSchedule{kind: kind, interval: d}, nil
+	}
+
+	return nil, fmt.Errorf("unknown schedule %q (expected: daily, weekly[:day], monthly[:day], every:<duration>)", s)
+}
+
+// parseRecurInterval parses a Go duration or a number of days ("10d").
+func parseRecurInterval(s string) (time.Duration, error) {
+	var d time.Duration
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		d = time.Duration(n) * 24 * time.Hour
+	} else {
+		parsed, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		d = parsed
+	}
+
+	if d <= 0 {
+		return 0, fmt.Errorf("duration must be positive: %q", s)
+	}
+	return d, nil
+}
+
+// parseWeekday parses a weekday name or its three-letter abbreviation.
+func parseWeekday(s string) (time.Weekday, bool) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		name := strings.ToLower(wd.String())
+		if s == name || s == name[:3] {
+			return wd, true
+		}
+	}
+	return 0, false
+}
+
+// next returns the first scheduled time after last.
+func (s *recurSchedule) next(last time.Time) time.Time {
+	if s.kind == "every" {
+		return last.Add(s.interval)
+	}
+
+	day := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, last.Location())
+
+	switch s.kind {
+	case "weekly":
+		offset := (int(s.weekday) - int(day.Weekday()) + 7) % 7
+		if offset == 0 {
+			offset = 7
+		}
+		return day.AddDate(0, 0, offset)
+
+	case "monthly":
+		candidate := monthDay(day.Year(), day.Month(), s.day, day.Location())
+		if !candidate.After(day) {
+			candidate = monthDay(day.Year(), day.Month()+1, s.day, day.Location())
+		}
+		return candidate
+	}
+
+	// daily
+	return day.AddDate(0, 0, 1)
+}
+
+// due reports whether an entry last created at last should be created at now.
+// An entry that was never created is always due.
+func (s *recurSchedule) due(last, now time.Time) bool {
+	if last.IsZero() {
+		return true
+	}
+	return !s.next(last.In(now.Location())).After(now)
+}
+
+// monthDay returns the given day of a month, clamped to the month's last day.
+func monthDay(year int, month time.Month, day int, loc *time.Location) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
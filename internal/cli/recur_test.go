@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestRecurScheduleDue(t *testing.T) {
+	// 2026-10-14 is a Wednesday
+	last := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		schedule string
+		last     time.Time
+		now      time.Time
+		want     bool
+	}{
+		{"daily", time.Time{}, last, true},
+		{"daily", last, last.Add(10 * time.Hour), false},
+		{"daily", last, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), true},
+		{"weekly:mon", last, time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC), false},
+		{"weekly:mon", last, time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC), true},
+		{"weekly:wednesday", last, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC), false},
+		{"weekly:wednesday", last, time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC), true},
+		{"monthly", last, time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC), false},
+		{"monthly", last, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), true},
+		{"monthly:20", last, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC), true},
+		{"monthly:31", time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC), time.Date(2026, 11, 29, 0, 0, 0, 0, time.UTC), false},
+		{"monthly:31", time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC), time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC), true},
+		{"every:36h", last, last.Add(35 * time.Hour), false},
+		{"every:36h", last, last.Add(36 * time.Hour), true},
+		{"every:10d", last, last.AddDate(0, 0, 10), true},
+	}
+
+	for _, tt := range tests {
+		sched, err := parseRecurSchedule(tt.schedule)
+		if err != nil {
+			t.Fatalf("parseRecurSchedule(%q) error = %v", tt.schedule, err)
+		}
+		if got := sched.due(tt.last, tt.now); got != tt.want {
+			t.Errorf("%s: due(%v, %v) = %v, want %v", tt.schedule, tt.last, tt.now, got, tt.want)
+		}
+	}
+}
+
+func TestParseRecurScheduleInvalid(t *testing.T) {
+	for _, s := range []string{"", "hourly", "weekly:funday", "monthly:0", "monthly:32", "every:", "every:-1h", "every:xd"} {
+		if _, err := parseRecurSchedule(s); err == nil {
+			t.Errorf("parseRecurSchedule(%q) expected error", s)
+		}
+	}
+}
+
+func TestExpandPlaceholders(t *testing.T) {
+	orig := gitConfigValue
+	defer func() { gitConfigValue = orig }()
+	gitConfigValue = func(key string) string {
+		if key == "user.name" {
+			return "alice"
+		}
+		return ""
+	}
+
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	got := expandPlaceholders("Sync {{date}} / {{week}} / {{month}} / {{year}}", now, "")
+	want := "Sync 2026-10-15 / 2026-W42 / 2026-10 / 2026"
+	if got != want {
+		t.Errorf("expandPlaceholders() = %q, want %q", got, want)
+	}
+
+	got = expandPlaceholders("# {{title}}\nBy {{author}}, {{unknown}} and {{ date }} stay", now, "Fix login")
+	want = "# Fix login\nBy alice, {{unknown}} and {{ date }} stay"
+	if got != want {
+		t.Errorf("expandPlaceholders() = %q, want %q", got, want)
+	}
+
+	gitConfigValue = func(string) string { return "" }
+	if got := expandPlaceholders("{{author}} {{title}}", now, ""); got != "{{author}} {{title}}" {
+		t.Errorf("tokens without a value should stay verbatim, got %q", got)
+	}
+}
+
+func TestRunRecurRunSavesBeforeError(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, recurringFileName)
+	content := "recurring:\n  - title: Daily\n    schedule: daily\n  - title: Broken\n    schedule: hourly\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringArray("project", nil, "")
+	cmd.Flags().String("dir", ".issues", "")
+	if err := cmd.Flags().Set("dir", dir); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runRecurRun(cmd, nil); err == nil || !strings.Contains(err.Error(), "Broken") {
+		t.Fatalf("runRecurRun() = %v, want an error for the broken schedule", err)
+	}
+
+	// The issue created before the error is recorded, so it is not created again
+	rf, err := loadRecurringFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rf.Recurring[0].LastCreated.IsZero() {
+		t.Error("last_created of the created issue was not saved")
+	}
+}
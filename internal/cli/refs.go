@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+var refsCmd = &cobra.Command{
+	Use:   "refs [number]",
+	Short: "Show issue references",
+	Long: `Show the reference graph of an issue, or report broken references.
+
+With a number, shows which issues it mentions and which mention it
+(same as 'zap show <number> --refs' without the issue details).
+With --broken, scans all issue bodies for #N mentions of issues that
+do not exist, such as typos or references to deleted issues.
+
+Examples:
+  zap refs 12            # Reference graph of issue #12
+  zap refs 12 --depth 1  # Direct references only
+  zap refs --broken      # Report dangling #N references`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeIssueNumber,
+	RunE:              runRefs,
+}
+
+var (
+	refsBroken bool
+	refsDepth  int
+)
+
+func init() {
+	rootCmd.AddCommand(refsCmd)
+	refsCmd.Flags().BoolVar(&refsBroken, "broken", false, "Report references to issues that do not exist")
+	refsCmd.Flags().IntVar(&refsDepth, "depth", 0, "Limit the tree depth (0 = unlimited)")
+}
+
+// brokenRef is a #N mention of an issue that does not exist.
+type brokenRef struct {
+	From   *issue.Issue
+	Target int
+}
+
+func runRefs(cmd *cobra.Command, args []string) error {
+	if !refsBroken && len(args) == 0 {
+		return fmt.Errorf("specify an issue number or use --broken")
+	}
+
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+	store := issue.NewStore(dir)
+
+	if !refsBroken {
+		number, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid issue number: %s", args[0])
+		}
+		if _, err := store.Get(number); err != nil {
+			return err
+		}
+		printRefsGraph(store, number, refsDepth)
+		return nil
+	}
+
+	broken, err := findBrokenRefs(store)
+	if err != nil {
+		return err
+	}
+
+	if len(broken) == 0 {
+		fmt.Println("✅ No broken references found.")
+		return nil
+	}
+
+	fmt.Printf("Broken references (%d):\n", len(broken))
+	for _, ref := range broken {
+		fmt.Printf("  #%-4d %s %s\n", ref.From.Number,
+			colorize(fmt.Sprintf("%s #%d", glyph("→", "->"), ref.Target), colorRed),
+			colorize(ref.From.Title, colorGray))
+	}
+
+	return fmt.Errorf("found %d broken reference(s)", len(broken))
+}
+
+// findBrokenRefs returns #N mentions in issue bodies whose target does not exist.
+// Files that fail to parse still count as existing issues.
+func findBrokenRefs(store *issue.Store) ([]brokenRef, error) {
+	issues, err := store.List(issue.AllStates()...)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers := make(map[int]bool)
+	for _, iss := range issues {
+		numbers[iss.Number] = true
+	}
+	for _, w := range store.Warnings() {
+		if num := issue.NumberFromFilename(w.FileName); num > 0 {
+			numbers[num] = true
+		}
+	}
+
+	var broken []brokenRef
+	for _, iss := range issues {
+		for _, ref := range issue.ExtractRefs(iss.Body) {
+			if ref > 0 && !numbers[ref] {
+				broken = append(broken, brokenRef{From: iss, Target: ref})
+			}
+		}
+	}
+
+	sort.Slice(broken, func(i, j int) bool {
+		if broken[i].From.Number != broken[j].From.Number {
+			return broken[i].From.Number < broken[j].From.Number
+		}
+		return broken[i].Target < broken[j].Target
+	})
+
+	return broken, nil
+}
+
+// RefGraphJSON is the reference graph of an issue for show --refs --json.
+type RefGraphJSON struct {
+	Root  int           `json:"root"`
+	Nodes []RefNodeJSON `json:"nodes"`
+	Edges []RefEdgeJSON `json:"edges"`
+}
+
+// RefNodeJSON is an issue in the reference graph.
+type RefNodeJSON struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+}
+
+// RefEdgeJSON is a mention: the body of From refers to To. Direction is
+// mentioned_by when the edge points toward the root, that is To is fewer
+// tree levels from the root than From, and mentions otherwise.
+type RefEdgeJSON struct {
+	From      int    `json:"from"`
+	To        int    `json:"to"`
+	Direction string `json:"direction"`
+}
+
+// buildRefGraphJSON collects the issues of the reference tree of root as
+// nodes, root first and the rest in tree order, and every mention between
+// two of them as edges, including those the tree leaves out. A depth above
+// zero limits the tree like --depth does.
+func buildRefGraphJSON(graph *issue.RefGraph, root *issue.Issue, depth int) RefGraphJSON {
+	node := func(iss *issue.Issue) RefNodeJSON {
+		return RefNodeJSON{Number: iss.Number, Title: iss.Title, State: string(iss.State)}
+	}
+	out := RefGraphJSON{Root: root.Number, Nodes: []RefNodeJSON{node(root)}, Edges: []RefEdgeJSON{}}
+
+	// levels holds the tree level of each node, 0 for the root
+	levels := map[int]int{root.Number: 0}
+	var walk func(nodes []*issue.TreeNode, level int)
+	walk = func(nodes []*issue.TreeNode, level int) {
+		if depth > 0 && level > depth {
+			return
+		}
+		for _, n := range nodes {
+			out.Nodes = append(out.Nodes, node(n.Issue))
+			levels[n.Issue.Number] = level
+			walk(n.Children, level+1)
+		}
+	}
+	walk(graph.BuildTree(root.Number), 1)
+
+	seen := make(map[[2]int]bool)
+	for _, n := range out.Nodes {
+		for _, to := range graph.Mentions[n.Number] {
+			toLevel, ok := levels[to]
+			if !ok || seen[[2]int{n.Number, to}] {
+				continue
+			}
+			seen[[2]int{n.Number, to}] = true
+			direction := issue.RefMentions
+			if toLevel < levels[n.Number] {
+				direction = issue.RefMentionedBy
+			}
+			out.Edges = append(out.Edges, RefEdgeJSON{From: n.Number, To: to, Direction: string(direction)})
+		}
+	}
+	return out
+}
+
+// writeRefGraphDOT writes a reference graph in Graphviz DOT. Edges point
+// from the mentioning issue to the mentioned one; the root is drawn bold.
+func writeRefGraphDOT(w io.Writer, g RefGraphJSON) error {
+	var sb strings.Builder
+	sb.WriteString("digraph refs {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box];\n")
+	for _, n := range g.Nodes {
+		attrs := fmt.Sprintf("label=%s", dotQuote(fmt.Sprintf("#%d %s\n[%s]", n.Number, n.Title, n.State)))
+		if n.Number == g.Root {
+			attrs += ", style=bold"
+		}
+		fmt.Fprintf(&sb, "  %d [%s];\n", n.Number, attrs)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&sb, "  %d -> %d;\n", e.From, e.To)
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// dotQuote returns s as a DOT string literal.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+func TestFindBrokenRefs(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"001-first.md":  "---\nnumber: 1\ntitle: First\nstate: open\n---\nSee #2 and #9.\n\n```\nzap show #77\n```\nDocs: https://example.com/#78\n",
+		"002-second.md": "---\nnumber: 2\ntitle: Second\nstate: open\n---\nBlocked by #3, typo #42, back to #1.\n",
+		"003-broken.md": "---\nnumber: [\n---\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	broken, err := findBrokenRefs(issue.NewStore(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, ref := range broken {
+		got = append(got, fmt.Sprintf("%d->%d", ref.From.Number, ref.Target))
+	}
+	want := []string{"1->9", "2->42"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("findBrokenRefs() = %v, want %v", got, want)
+	}
+}
+
+func TestBuildRefGraphJSON(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"001-root.md":   "---\nnumber: 1\ntitle: Root\nstate: open\n---\nSee #2.\n",
+		"002-child.md":  "---\nnumber: 2\ntitle: Child\nstate: wip\n---\nNeeds #3.\n",
+		"003-leaf.md":   "---\nnumber: 3\ntitle: Say \"hi\"\nstate: done\n---\n",
+		"004-parent.md": "---\nnumber: 4\ntitle: Parent\nstate: open\n---\nFollow-up of #1.\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store := issue.NewStore(dir)
+	graph, err := store.BuildRefGraph()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		depth int
+		nodes string
+		edges string
+	}{
+		{0, "[1 2 3 4]", "[1->2 mentions 2->3 mentions 4->1 mentioned_by]"},
+		{1, "[1 2 4]", "[1->2 mentions 4->1 mentioned_by]"},
+	}
+	for _, tt := range tests {
+		refs := buildRefGraphJSON(graph, graph.Issues[1], tt.depth)
+
+		var nodes, edges []string
+		for _, n := range refs.Nodes {
+			nodes = append(nodes, fmt.Sprint(n.Number))
+		}
+		for _, e := range refs.Edges {
+			edges = append(edges, fmt.Sprintf("%d->%d %s", e.From, e.To, e.Direction))
+		}
+		if fmt.Sprint(nodes) != tt.nodes || fmt.Sprint(edges) != tt.edges {
+			t.Errorf("depth %d: nodes %v edges %v, want %s %s", tt.depth, nodes, edges, tt.nodes, tt.edges)
+		}
+	}
+
+	// A diamond keeps the edge the tree leaves out
+	diamond := map[string]string{
+		"001-root.md":  "---\nnumber: 1\ntitle: Root\nstate: open\n---\nSee #2 and #3.\n",
+		"002-left.md":  "---\nnumber: 2\ntitle: Left\nstate: open\n---\nNeeds #3.\n",
+		"003-right.md": "---\nnumber: 3\ntitle: Right\nstate: open\n---\nBack to #1.\n",
+	}
+	diamondDir := t.TempDir()
+	for name, content := range diamond {
+		if err := os.WriteFile(filepath.Join(diamondDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	diamondGraph, err := issue.NewStore(diamondDir).BuildRefGraph()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var edges []string
+	for _, e := range buildRefGraphJSON(diamondGraph, diamondGraph.Issues[1], 0).Edges {
+		edges = append(edges, fmt.Sprintf("%d->%d %s", e.From, e.To, e.Direction))
+	}
+	if want := "[1->2 mentions 1->3 mentions 2->3 mentions 3->1 mentioned_by]"; fmt.Sprint(edges) != want {
+		t.Errorf("diamond edges %v, want %s", edges, want)
+	}
+
+	var sb strings.Builder
+	if err := writeRefGraphDOT(&sb, buildRefGraphJSON(graph, graph.Issues[1], 0)); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"digraph refs {", `1 [label="#1 Root\n[open]", style=bold];`, `3 [label="#3 Say \"hi\"\n[done]"];`, "4 -> 1;"} {
+		if !strings.Contains(sb.String(), want) {
+			t.Errorf("DOT output missing %q:\n%s", want, sb.String())
+		}
+	}
+}
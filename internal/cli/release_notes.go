@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
@@ -188,32 +186,53 @@ type FileStats struct {
 	Files    []string
 }
 
-// getFileStats retrieves file change statistics between two refs.
-func getFileStats(fromRef, toRef string) (*FileStats, error) {
-	// Get list of changed files with status
-	cmd := exec.Command("git", "diff", "--name-status", fmt.Sprintf("%s..%s", fromRef, toRef))
+// fileChange is a single entry of `git diff --name-status`.
+type fileChange struct {
+	Status  byte   // A, M, D, R, ...
+	Path    string // Path in the "to" revision (or the deleted path)
+	OldPath string // Path in the "from" revision (differs for renames)
+}
+
+// getNameStatus runs `git diff --name-status` in dir (the current directory
+// if empty) and parses its output. Paths are relative to the repository root.
+// Extra arguments (e.g. "--relative", "--", "path") are appended as-is.
+func getNameStatus(dir, fromRef, toRef string, extraArgs ...string) ([]fileChange, error) {
+	args := append([]string{"diff", "--name-status", fmt.Sprintf("%s..%s", fromRef, toRef)}, extraArgs...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
 	output, err := cmd.Output()
 	if err != nil {
 		return nil, err
 	}
 
-	stats := &FileStats{}
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
-		parts := strings.Fields(line)
-		if len(parts) < 2 {
+	var changes []fileChange
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		parts := strings.Split(line, "\t")
+		if len(parts) < 2 || parts[0] == "" {
 			continue
 		}
+		changes = append(changes, fileChange{
+			Status:  parts[0][0],
+			Path:    parts[len(parts)-1],
+			OldPath: parts[1],
+		})
+	}
 
-		status := parts[0]
-		file := parts[len(parts)-1]
-		stats.Files = append(stats.Files, file)
+	return changes, nil
+}
 
-		switch status[0] {
+// getFileStats retrieves file change statistics between two refs.
+func getFileStats(fromRef, toRef string) (*FileStats, error) {
+	changes, err := getNameStatus("", fromRef, toRef)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &FileStats{}
+	for _, c := range changes {
+		stats.Files = append(stats.Files, c.Path)
+
+		switch c.Status {
 		case 'A':
 			stats.Added++
 		case 'D':
@@ -235,15 +254,11 @@ func findRelatedIssues(issuesDir string, commits []CommitInfo) []*issue.Issue {
 	}
 
 	// Extract issue numbers mentioned in commits
+	loadRefMatcher(issuesDir)
 	issueNumbers := make(map[int]bool)
-	issuePattern := regexp.MustCompile(`#(\d+)`)
-
 	for _, c := range commits {
-		// Check subject and body for issue references
-		for _, match := range issuePattern.FindAllStringSubmatch(c.Subject+" "+c.Body, -1) {
-			if num, err := strconv.Atoi(match[1]); err == nil {
-				issueNumbers[num] = true
-			}
+		for _, num := range extractIssueRefs(c.Subject + " " + c.Body) {
+			issueNumbers[num] = true
 		}
 	}
 
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+var renameCmd = &cobra.Command{
+	Use:     "mv <number> --title <title>",
+	Aliases: []string{"rename"},
+	Short:   "Rename an issue",
+	Long: `Change an issue's title and regenerate its filename slug.
+
+The issue number is preserved. Use --keep-filename to change only the title
+and leave the file where it is.
+
+Examples:
+  zap mv 3 --title "Support OAuth login"
+  zap mv 3 --title "Support OAuth login" --keep-filename`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeIssueNumber,
+	RunE:              runRename,
+}
+
+var (
+	renameTitle        string
+	renameKeepFilename bool
+)
+
+func init() {
+	rootCmd.AddCommand(renameCmd)
+	renameCmd.Flags().StringVarP(&renameTitle, "title", "t", "", "New issue title (required)")
+	renameCmd.Flags().BoolVar(&renameKeepFilename, "keep-filename", false, "Update the title without renaming the file")
+	_ = renameCmd.MarkFlagRequired("title")
+}
+
+func runRename(cmd *cobra.Command, args []string) error {
+	number, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid issue number: %s", args[0])
+	}
+
+	title := strings.TrimSpace(renameTitle)
+	if title == "" {
+		return fmt.Errorf("title cannot be empty")
+	}
+
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	store := issue.NewStore(dir)
+	iss, err := store.Get(number)
+	if err != nil {
+		return err
+	}
+
+	oldPath := iss.FilePath
+	undo := newUndoRecorder(dir, fmt.Sprintf("mv %d", number))
+	undo.track(oldPath)
+	undo.track(filepath.Join(filepath.Dir(oldPath), issue.Filename(iss.Number, title)))
+
+	if err := renameIssue(iss, title, renameKeepFilename); err != nil {
+		return err
+	}
+	undo.saveOrWarn()
+
+	fmt.Printf("✅ Renamed issue #%d: %s\n", iss.Number, iss.Title)
+	if iss.FilePath != oldPath {
+		fmt.Printf("   %s → %s\n", filepath.Base(oldPath), filepath.Base(iss.FilePath))
+	}
+
+	return nil
+}
+
+// renameIssue sets a new title on iss and, unless keepFilename is set,
+// renames its file to match the new slug. The issue number is preserved.
+func renameIssue(iss *issue.Issue, title string, keepFilename bool) error {
+	oldPath := iss.FilePath
+	newPath := oldPath
+
+	if !keepFilename {
+		newFilename := issue.Filename(iss.Number, title)
+		newPath = filepath.Join(filepath.Dir(oldPath), newFilename)
+
+		// Check if new path already exists
+		if newPath != oldPath {
+			if _, err := os.Stat(newPath); err == nil {
+				return fmt.Errorf("target file already exists: %s", newFilename)
+			}
+		}
+	}
+
+	iss.Title = title
+	iss.UpdatedAt = time.Now().UTC()
+	if err := iss.Validate(); err != nil {
+		return err
+	}
+
+	data, err := issue.Serialize(iss)
+	if err != nil {
+		return fmt.Errorf("failed to serialize issue: %w", err)
+	}
+
+	if err := os.WriteFile(oldPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write issue file: %w", err)
+	}
+
+	if newPath != oldPath {
+		if err := os.Rename(oldPath, newPath); err != nil {
+			return fmt.Errorf("failed to rename file: %w", err)
+		}
+		iss.FilePath = newPath
+	}
+
+	return nil
+}
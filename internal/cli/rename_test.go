@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+func TestRenameIssue(t *testing.T) {
+	tests := []struct {
+		name         string
+		keepFilename bool
+		existing     string
+		wantFile     string
+		wantErr      bool
+	}{
+		{"renames file to new slug", false, "", "003-new-title.md", false},
+		{"keep filename", true, "", "003-old-title.md", false},
+		{"collision", false, "003-new-title.md", "003-old-title.md", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			iss := &issue.Issue{
+				Number:    3,
+				Title:     "Old title",
+				State:     issue.StateOpen,
+				CreatedAt: created,
+				UpdatedAt: created,
+				FilePath:  filepath.Join(dir, "003-old-title.md"),
+			}
+			data, err := issue.Serialize(iss)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(iss.FilePath, data, 0644); err != nil {
+				t.Fatal(err)
+			}
+			if tt.existing != "" {
+				if err := os.WriteFile(filepath.Join(dir, tt.existing), []byte("x"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err = renameIssue(iss, "New title", tt.keepFilename)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("renameIssue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			path := filepath.Join(dir, tt.wantFile)
+			if iss.FilePath != path {
+				t.Errorf("FilePath = %s, want %s", iss.FilePath, path)
+			}
+
+			got, err := issue.Parse(path)
+			if err != nil {
+				t.Fatalf("failed to parse renamed issue: %v", err)
+			}
+			if got.Number != 3 || got.Title != "New title" {
+				t.Errorf("got #%d %q, want #3 \"New title\"", got.Number, got.Title)
+			}
+			if !got.UpdatedAt.After(created) {
+				t.Errorf("UpdatedAt not bumped: %v", got.UpdatedAt)
+			}
+		})
+	}
+}
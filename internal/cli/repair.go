@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strconv"
+	"sort"
 	"strings"
 	"time"
 
@@ -22,23 +22,28 @@ var repairCmd = &cobra.Command{
 Without arguments, shows files that need repair.
 With --auto flag, automatically repairs all failed files without confirmation.
 With --all flag, repairs all failed files (with confirmation).
-With number arguments, repairs specific files sequentially.
+With number arguments, repairs specific files sequentially. Ranges such as
+10-20 may be mixed with single numbers.
 
 Examples:
   zap repair              # Show files that need repair
   zap repair --auto       # Auto-repair all failed files
   zap repair 155          # Repair issue #155
   zap repair 155 159      # Repair issues #155 and #159
-  zap repair --all        # Repair all failed files (with confirmation)`,
+  zap repair 10-20 25     # Repair issues #10 through #20 and #25
+  zap repair --all        # Repair all failed files (with confirmation)
+  zap repair 155 --with-context  # Let AI see other issues to avoid number conflicts`,
 	RunE: runRepair,
 }
 
 var (
-	repairAll    bool
-	repairAuto   bool
-	repairDryRun bool
-	repairAI     string
-	repairYes    bool
+	repairAll     bool
+	repairAuto    bool
+	repairDryRun  bool
+	repairAI      string
+	repairAIModel string
+	repairYes     bool
+	repairContext bool
 )
 
 func init() {
@@ -48,7 +53,9 @@ func init() {
 	repairCmd.Flags().BoolVar(&repairAuto, "auto", false, "Automatically repair all files without confirmation (same as --all --yes)")
 	repairCmd.Flags().BoolVar(&repairDryRun, "dry-run", false, "Show what would be changed without modifying files")
 	repairCmd.Flags().StringVar(&repairAI, "ai", "", "AI CLI to use (claude, codex, gemini)")
+	repairCmd.Flags().StringVar(&repairAIModel, "ai-model", "", "AI model to use (overrides ai.yaml)")
 	repairCmd.Flags().BoolVarP(&repairYes, "yes", "y", false, "Skip confirmation prompts")
+	repairCmd.Flags().BoolVar(&repairContext, "with-context", false, "Include a summary of valid sibling issues in the AI prompt")
 }
 
 func runRepair(cmd *cobra.Command, args []string) error {
@@ -96,19 +103,20 @@ func runRepair(cmd *cobra.Command, args []string) error {
 
 	if len(args) > 0 {
 		// Repair specific issues by number
-		for _, arg := range args {
-			number, err := strconv.Atoi(arg)
-			if err != nil {
-				return fmt.Errorf("invalid issue number: %s", arg)
-			}
-
+		numbers, err := parseNumberSelectors(args)
+		if err != nil {
+			return err
+		}
+		var skipped []int
+		for _, number := range numbers {
 			failure := store.GetFailureByNumber(number)
 			if failure == nil {
-				fmt.Printf("⚠️  No parse failure found for issue #%d, skipping\n", number)
+				skipped = append(skipped, number)
 				continue
 			}
 			toRepair = append(toRepair, *failure)
 		}
+		printSkippedSelectors(skipped, "no parse failure found")
 		if len(toRepair) == 0 {
 			return fmt.Errorf("no valid parse failures found for the specified issues")
 		}
@@ -125,7 +133,7 @@ func runRepair(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get AI client
-	client, err := getAIClient(repairAI)
+	client, err := getAIClient(dir, repairAI, repairAIModel)
 	if err != nil {
 		return err
 	}
@@ -138,18 +146,32 @@ func runRepair(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("repair-frontmatter template not found")
 	}
 
+	// Summarize valid sibling issues so the AI can pick a non-conflicting number
+	siblings := ""
+	if repairContext {
+		issues, err := store.List(issue.AllStates()...)
+		if err != nil {
+			return fmt.Errorf("failed to list issues: %w", err)
+		}
+		siblings = summarizeSiblingIssues(issues)
+	}
+
 	cfg, _ := ai.LoadConfig()
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout*time.Duration(len(toRepair)))
 	defer cancel()
 
+	undo := newUndoRecorder(dir, "repair")
+
 	successCount := 0
 	for _, failure := range toRepair {
 		fmt.Printf("Processing %s...\n", failure.FileName)
 
 		// Render prompt
 		req, err := tmpl.Render(map[string]string{
-			"filename": failure.FileName,
-			"content":  failure.Content,
+			"filename":     failure.FileName,
+			"content":      failure.Content,
+			"all_issues":   siblings,
+			"valid_states": issue.ValidStates(),
 		})
 		if err != nil {
 			fmt.Printf("  ❌ Failed to render prompt: %v\n", err)
@@ -170,6 +192,15 @@ func runRepair(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  ❌ AI response doesn't look like valid frontmatter\n")
 			continue
 		}
+		repaired, err := issue.ParseBytes([]byte(newContent), failure.FilePath)
+		if err != nil {
+			fmt.Printf("  ❌ AI response doesn't parse: %v\n", err)
+			continue
+		}
+		if err := repaired.Validate(); err != nil {
+			fmt.Printf("  ❌ AI response is not a valid issue: %v\n", err)
+			continue
+		}
 
 		if repairDryRun {
 			// Show diff
@@ -195,6 +226,7 @@ func runRepair(cmd *cobra.Command, args []string) error {
 			}
 
 			// Write new content
+			undo.track(failure.FilePath)
 			if err := os.WriteFile(failure.FilePath, []byte(newContent), 0644); err != nil {
 				fmt.Printf("  ❌ Failed to write file: %v\n", err)
 				// Restore from backup
@@ -210,12 +242,29 @@ func runRepair(cmd *cobra.Command, args []string) error {
 	if repairDryRun {
 		fmt.Printf("\nDry run complete. No files were modified.\n")
 	} else {
+		undo.saveOrWarn()
 		fmt.Printf("\nRepaired %d/%d files.\n", successCount, len(toRepair))
 	}
 
 	return nil
 }
 
+// summarizeSiblingIssues builds a compact "#number: title" list of issues,
+// sorted by number, for use as AI prompt context.
+func summarizeSiblingIssues(issues []*issue.Issue) string {
+	sorted := make([]*issue.Issue, len(issues))
+	copy(sorted, issues)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Number < sorted[j].Number
+	})
+
+	var sb strings.Builder
+	for _, iss := range sorted {
+		fmt.Fprintf(&sb, "#%d: %s\n", iss.Number, iss.Title)
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
 // cleanAIResponse removes markdown code blocks if present.
 func cleanAIResponse(content string) string {
 	content = strings.TrimSpace(content)
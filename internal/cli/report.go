@@ -1,20 +1,24 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"html"
 	"os"
 	"os/exec"
-	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/itda-work/zap/internal/ai"
+	"github.com/itda-work/zap/internal/i18n"
 	"github.com/itda-work/zap/internal/issue"
 	"github.com/spf13/cobra"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
 )
 
 var reportCmd = &cobra.Command{
@@ -29,6 +33,9 @@ The report includes:
 - File change statistics
 - AI-generated summary
 
+Report text and the AI prompt are in Korean by default. Set ZAP_LANG=en
+or 'lang: en' in .zap.yml for English.
+
 Examples:
   # Report for last week
   zap report --since 2025-01-13 --until 2025-01-19
@@ -49,27 +56,72 @@ Examples:
   zap report --days 7 -o report.md
 
   # JSON format
-  zap report --days 7 --format json`,
+  zap report --days 7 --format json
+
+  # Self-contained HTML for email or wikis
+  zap report --days 7 --format html -o report.html
+
+  # Live rolling report for standups
+  zap report --days 1 --watch
+
+  # Compare this week against the week before
+  zap report --weeks 1 --compare
+
+  # Print the prompt sent to the AI (stderr)
+  zap report --days 7 --show-prompt
+
+  # Byte-stable report to commit into the repository
+  zap report --since 2025-01-13 --until 2025-01-19 --raw -o reports/w03.md
+
+  # Only the issue progress, e.g. for a planning meeting
+  zap report --weeks 1 --issues-only
+
+  # Only the commit list
+  zap report --weeks 1 --commits-only
+
+With --raw there is no AI summary and commits (by date, then hash) and
+issues (by number) are sorted, so the same repository state always gives
+the same bytes. Give an end date with --until (or use --today); otherwise
+the period ends now and the output changes over time.
+
+--issues-only and --commits-only leave out the other sections, including the
+file statistics. The AI summary is still written from the whole period.`,
 	RunE: runReport,
 }
 
 var (
-	reportFormat     string
-	reportOutput     string
-	reportAI         string
-	reportTimeout    time.Duration
-	reportDateFilter DateFilter
-	reportNoAI       bool
+	reportFormat      string
+	reportOutput      string
+	reportAI          string
+	reportAIModel     string
+	reportTimeout     time.Duration
+	reportDateFilter  DateFilter
+	reportNoAI        bool
+	reportWatch       bool
+	reportInterval    time.Duration
+	reportCompare     bool
+	reportShowPrompt  bool
+	reportRaw         bool
+	reportIssuesOnly  bool
+	reportCommitsOnly bool
 )
 
 func init() {
 	rootCmd.AddCommand(reportCmd)
 
-	reportCmd.Flags().StringVarP(&reportFormat, "format", "f", "markdown", "Output format (markdown, text, json)")
+	reportCmd.Flags().StringVarP(&reportFormat, "format", "f", "markdown", "Output format (markdown, text, json, html)")
 	reportCmd.Flags().StringVarP(&reportOutput, "output", "o", "", "Write output to file instead of stdout")
 	reportCmd.Flags().StringVar(&reportAI, "ai", "", "AI provider to use (claude, codex, gemini)")
+	reportCmd.Flags().StringVar(&reportAIModel, "ai-model", "", "AI model to use (overrides ai.yaml)")
 	reportCmd.Flags().DurationVar(&reportTimeout, "timeout", 120*time.Second, "AI request timeout")
 	reportCmd.Flags().BoolVar(&reportNoAI, "no-ai", false, "Skip AI summary generation")
+	reportCmd.Flags().BoolVar(&reportShowPrompt, "show-prompt", false, "Print the prompt sent to the AI to stderr")
+	reportCmd.Flags().BoolVar(&reportRaw, "raw", false, "Reproducible output for committing: no AI summary, deterministic ordering")
+	reportCmd.Flags().BoolVar(&reportIssuesOnly, "issues-only", false, "Show only the issue progress section")
+	reportCmd.Flags().BoolVar(&reportCommitsOnly, "commits-only", false, "Show only the commit list")
+	reportCmd.Flags().BoolVarP(&reportWatch, "watch", "w", false, "Regenerate the report on file changes and at a fixed interval")
+	reportCmd.Flags().DurationVar(&reportInterval, "interval", time.Minute, "Refresh interval for --watch")
+	reportCmd.Flags().BoolVar(&reportCompare, "compare", false, "Compare against the preceding period of the same length")
 
 	// Date filter options
 	reportCmd.Flags().BoolVar(&reportDateFilter.Today, "today", false, "Report for today")
@@ -83,12 +135,40 @@ func init() {
 type ReportData struct {
 	Period     string
 	Since      time.Time
-	Until      time.Time
+	Until      time.Time // Last moment covered (inclusive)
 	Summary    string
 	Commits    []CommitInfo
 	Issues     []*issue.Issue
 	IssueLinks map[int][]CommitInfo // issue number -> related commits
+	ResolvedBy map[int]string       // issue number -> hash of the commit that closes it
 	FileStats  *FileStats
+	Previous   *ReportData // Preceding period of the same length (--compare)
+
+	// Sections left out by --issues-only or --commits-only
+	OmitCommits bool
+	OmitIssues  bool
+}
+
+// reportMetrics are the numbers compared between periods.
+type reportMetrics struct {
+	Commits      int
+	IssuesClosed int
+	FilesChanged int
+}
+
+// metrics counts commits, issues closed and files changed in the period.
+func (d *ReportData) metrics() reportMetrics {
+	m := reportMetrics{Commits: len(d.Commits)}
+	end := d.Until.Add(time.Nanosecond)
+	for _, iss := range d.Issues {
+		if iss.ClosedAt != nil && matchesDateRange(*iss.ClosedAt, d.Since, end) {
+			m.IssuesClosed++
+		}
+	}
+	if d.FileStats != nil {
+		m.FilesChanged = len(d.FileStats.Files)
+	}
+	return m
 }
 
 func runReport(cmd *cobra.Command, args []string) error {
@@ -97,57 +177,49 @@ func runReport(cmd *cobra.Command, args []string) error {
 		return err
 	}
 	store := issue.NewStore(dir)
+	loadLanguage(dir)
+	loadRefMatcher(dir)
 
-	// Determine report mode based on arguments
-	var reportData *ReportData
-
-	if len(args) > 0 {
-		// Check if first arg looks like a commit range (contains "..")
-		if strings.Contains(args[0], "..") {
-			// Commit range mode
-			reportData, err = buildReportFromCommitRange(store, args[0])
-		} else if isNumeric(args[0]) {
-			// Issue numbers mode
-			reportData, err = buildReportFromIssueNumbers(store, args)
-		} else {
-			// Assume single ref to HEAD
-			reportData, err = buildReportFromCommitRange(store, args[0]+"..HEAD")
-		}
-	} else if !reportDateFilter.IsEmpty() {
-		// Date filter mode
-		reportData, err = buildReportFromDateFilter(store, &reportDateFilter)
-	} else {
-		return fmt.Errorf("please specify a date range (--since, --days, etc.), commit range (v1.0..HEAD), or issue numbers")
+	if reportRaw && reportWatch {
+		return fmt.Errorf("--raw cannot be used with --watch")
+	}
+	if reportIssuesOnly && reportCommitsOnly {
+		return fmt.Errorf("--issues-only and --commits-only cannot be used together")
+	}
+	if (reportIssuesOnly || reportCommitsOnly) && reportCompare {
+		return fmt.Errorf("--issues-only and --commits-only cannot be used with --compare")
+	}
+	if reportWatch {
+		return runReportWatch(dir, store, args)
 	}
 
+	reportData, err := buildReport(store, args)
 	if err != nil {
 		return err
 	}
 
+	if reportRaw {
+		sortReportData(reportData)
+		if len(args) == 0 && reportPeriodEndsNow(&reportDateFilter) {
+			fmt.Fprintf(os.Stderr, "note: the period ends now, so --raw output will change over time (pass --until for a fixed period)\n")
+		}
+	}
+
 	// Generate AI summary if not disabled and there's content to summarize
-	if !reportNoAI && (len(reportData.Commits) > 0 || len(reportData.Issues) > 0) {
+	if !reportNoAI && !reportRaw && hasReportContent(reportData) {
 		fmt.Fprintf(os.Stderr, "🤖 Generating AI summary...\n")
-		summary, aiErr := generateReportSummary(reportData)
+		summary, aiErr := generateReportSummary(dir, reportData)
 		if aiErr != nil {
 			fmt.Fprintf(os.Stderr, "⚠️  Failed to generate AI summary: %v\n", aiErr)
 		} else {
 			reportData.Summary = summary
 		}
 	}
+	trimReportSections(reportData, reportIssuesOnly, reportCommitsOnly)
 
-	// Format output
-	var output string
-	switch reportFormat {
-	case "json":
-		data, err := formatReportJSON(reportData)
-		if err != nil {
-			return fmt.Errorf("failed to format JSON: %w", err)
-		}
-		output = string(data)
-	case "text":
-		output = formatReportText(reportData)
-	default:
-		output = formatReportMarkdown(reportData)
+	output, err := formatReport(reportData)
+	if err != nil {
+		return err
 	}
 
 	// Write output
@@ -163,6 +235,109 @@ func runReport(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// buildReport builds report data based on arguments and date filter flags.
+func buildReport(store *issue.Store, args []string) (*ReportData, error) {
+	if reportCompare && (len(args) > 0 || reportDateFilter.IsEmpty()) {
+		return nil, fmt.Errorf("--compare requires a date range (--since, --days, etc.)")
+	}
+
+	if len(args) > 0 {
+		// Check if first arg looks like a commit range (contains "..")
+		if strings.Contains(args[0], "..") {
+			// Commit range mode
+			return buildReportFromCommitRange(store, args[0])
+		} else if isNumeric(args[0]) {
+			// Issue numbers mode
+			return buildReportFromIssueNumbers(store, args)
+		}
+		// Assume single ref to HEAD
+		return buildReportFromCommitRange(store, args[0]+"..HEAD")
+	}
+
+	if !reportDateFilter.IsEmpty() {
+		// Date filter mode
+		return buildReportFromDateFilter(store, &reportDateFilter)
+	}
+
+	return nil, fmt.Errorf("please specify a date range (--since, --days, etc.), commit range (v1.0..HEAD), or issue numbers")
+}
+
+// sortReportData puts commits in a fixed order, newest day first and then
+// by hash, and issues by number, for --raw. The commits of each issue and
+// the closing commits are derived again from the sorted list.
+func sortReportData(data *ReportData) {
+	commitLess := func(a, b CommitInfo) bool {
+		if a.Date != b.Date {
+			return a.Date > b.Date
+		}
+		return a.Hash < b.Hash
+	}
+	sort.SliceStable(data.Commits, func(i, j int) bool {
+		return commitLess(data.Commits[i], data.Commits[j])
+	})
+	for _, commits := range data.IssueLinks {
+		sort.SliceStable(commits, func(i, j int) bool {
+			return commitLess(commits[i], commits[j])
+		})
+	}
+	data.ResolvedBy = resolvedByCommits(data.Commits)
+
+	sort.SliceStable(data.Issues, func(i, j int) bool {
+		return data.Issues[i].Number < data.Issues[j].Number
+	})
+	if data.FileStats != nil {
+		sort.Strings(data.FileStats.Files)
+	}
+	if data.Previous != nil {
+		sortReportData(data.Previous)
+	}
+}
+
+// trimReportSections drops the sections left out by --issues-only or
+// --commits-only. File statistics go with both. The links between issues
+// and commits are kept, since the issue notes are made from them.
+func trimReportSections(data *ReportData, issuesOnly, commitsOnly bool) {
+	if issuesOnly {
+		data.Commits = nil
+		data.FileStats = nil
+		data.OmitCommits = true
+	}
+	if commitsOnly {
+		data.Issues = nil
+		data.FileStats = nil
+		data.OmitIssues = true
+	}
+}
+
+// reportPeriodEndsNow reports whether a date filter's period runs up to the
+// current time rather than a fixed end date.
+func reportPeriodEndsNow(f *DateFilter) bool {
+	return f.Days > 0 || f.Weeks > 0 || (f.Since != "" && f.Until == "")
+}
+
+// hasReportContent reports whether there is anything to summarize.
+func hasReportContent(data *ReportData) bool {
+	return len(data.Commits) > 0 || len(data.Issues) > 0
+}
+
+// formatReport renders report data in the selected --format.
+func formatReport(data *ReportData) (string, error) {
+	switch reportFormat {
+	case "json":
+		out, err := formatReportJSON(data)
+		if err != nil {
+			return "", fmt.Errorf("failed to format JSON: %w", err)
+		}
+		return string(out), nil
+	case "text":
+		return formatReportText(data), nil
+	case "html":
+		return formatReportHTML(data)
+	default:
+		return formatReportMarkdown(data), nil
+	}
+}
+
 // isNumeric checks if a string is a number.
 func isNumeric(s string) bool {
 	_, err := strconv.Atoi(s)
@@ -181,7 +356,35 @@ func buildReportFromDateFilter(store *issue.Store, filter *DateFilter) (*ReportD
 		until = time.Now()
 	}
 
-	return buildReportForPeriod(store, since, until)
+	data, err := buildReportForPeriod(store, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	if reportCompare {
+		if since.IsZero() {
+			return nil, fmt.Errorf("--compare requires a start date")
+		}
+		prev, err := buildReportForPeriod(store, previousPeriodStart(since, until), since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build previous period: %w", err)
+		}
+		data.Previous = prev
+	}
+
+	return data, nil
+}
+
+// previousPeriodStart returns the start of the period that ends at since
+// and covers as many calendar days as [since, until). A partial last day,
+// as with --days, counts as a whole one, so "--days 7" compares against
+// the seven days before it.
+func previousPeriodStart(since, until time.Time) time.Time {
+	lastDay := until.Add(-time.Nanosecond)
+	first := time.Date(since.Year(), since.Month(), since.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(lastDay.Year(), lastDay.Month(), lastDay.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(last.Sub(first).Hours()/24) + 1
+	return since.AddDate(0, 0, -days)
 }
 
 // buildReportFromCommitRange builds report from git commit range.
@@ -237,6 +440,7 @@ func buildReportFromCommitRange(store *issue.Store, commitRange string) (*Report
 		Commits:    commits,
 		Issues:     relatedIssues,
 		IssueLinks: issueLinks,
+		ResolvedBy: resolvedByCommits(commits),
 		FileStats:  stats,
 	}, nil
 }
@@ -294,6 +498,7 @@ func buildReportFromIssueNumbers(store *issue.Store, args []string) (*ReportData
 		Commits:    relatedCommits,
 		Issues:     issues,
 		IssueLinks: issueLinks,
+		ResolvedBy: resolvedByCommits(relatedCommits),
 		FileStats:  &FileStats{},
 	}, nil
 }
@@ -323,13 +528,7 @@ func buildReportForPeriod(store *issue.Store, since, until time.Time) (*ReportDa
 		allIssues = nil
 	}
 
-	// Filter issues updated in this period
-	var periodIssues []*issue.Issue
-	for _, iss := range allIssues {
-		if matchesDateRange(iss.UpdatedAt, since, until) || matchesDateRange(iss.CreatedAt, since, until) {
-			periodIssues = append(periodIssues, iss)
-		}
-	}
+	periodIssues := issuesInPeriod(allIssues, since, until)
 
 	issueLinks := linkCommitsToIssues(commits, allIssues)
 
@@ -352,27 +551,55 @@ func buildReportForPeriod(store *issue.Store, since, until time.Time) (*ReportDa
 		return finalIssues[i].Number < finalIssues[j].Number
 	})
 
+	// until is exclusive (the next local midnight for --until/--date), so the
+	// last day in the period is the one just before it
+	lastDay := until.Add(-time.Nanosecond)
+
 	return &ReportData{
-		Period:     fmt.Sprintf("%s ~ %s", since.Format("2006-01-02"), until.Format("2006-01-02")),
+		Period:     fmt.Sprintf("%s ~ %s", since.Format("2006-01-02"), lastDay.Format("2006-01-02")),
 		Since:      since,
-		Until:      until,
+		Until:      lastDay,
 		Commits:    commits,
 		Issues:     finalIssues,
 		IssueLinks: issueLinks,
+		ResolvedBy: resolvedByCommits(commits),
 		FileStats:  stats,
 	}, nil
 }
 
-// getCommitsInDateRange gets commits within a date range.
-func getCommitsInDateRange(since, until time.Time) ([]CommitInfo, error) {
-	args := []string{"log", "--date=short", "--format=%H%x00%s%x00%b%x00%an%x00%ad%x00%x01"}
+// issuesInPeriod returns the issues created, updated or closed in
+// [since, until). Bounds are instants, so issue times stored in UTC are
+// compared correctly against local day boundaries.
+func issuesInPeriod(issues []*issue.Issue, since, until time.Time) []*issue.Issue {
+	var result []*issue.Issue
+	for _, iss := range issues {
+		closedInPeriod := iss.ClosedAt != nil && matchesDateRange(*iss.ClosedAt, since, until)
+		if matchesDateRange(iss.UpdatedAt, since, until) || matchesDateRange(iss.CreatedAt, since, until) || closedInPeriod {
+			result = append(result, iss)
+		}
+	}
+	return result
+}
 
+// gitDateRangeArgs returns git log arguments selecting commits in
+// [since, until). Full timestamps are passed because git reads a bare date
+// as that day at the current time of day.
+func gitDateRangeArgs(since, until time.Time) []string {
+	var args []string
 	if !since.IsZero() {
-		args = append(args, "--since="+since.Format("2006-01-02"))
+		args = append(args, "--since="+since.Format(time.RFC3339))
 	}
 	if !until.IsZero() {
-		args = append(args, "--until="+until.Add(24*time.Hour).Format("2006-01-02"))
+		// --until is inclusive and has second precision
+		args = append(args, "--until="+until.Add(-time.Second).Format(time.RFC3339))
 	}
+	return args
+}
+
+// getCommitsInDateRange gets commits within a date range.
+func getCommitsInDateRange(since, until time.Time) ([]CommitInfo, error) {
+	args := []string{"log", "--date=short", "--format=%H%x00%s%x00%b%x00%an%x00%ad%x00%x01"}
+	args = append(args, gitDateRangeArgs(since, until)...)
 
 	cmd := exec.Command("git", args...)
 	output, err := cmd.Output()
@@ -416,20 +643,64 @@ func parseCommitOutput(output string) ([]CommitInfo, error) {
 	return commits, nil
 }
 
-// extractIssueRefs extracts issue numbers from text (#N pattern).
+// extractIssueRefs extracts the issue numbers a commit message refers to,
+// in order of first appearance: #N, plus the refs.pattern of .zap.yml.
+// Code spans, code blocks and URLs are skipped.
 func extractIssueRefs(text string) []int {
-	pattern := regexp.MustCompile(`#(\d+)`)
-	matches := pattern.FindAllStringSubmatch(text, -1)
+	return commitRefs.Numbers(text)
+}
 
-	var refs []int
-	seen := make(map[int]bool)
-	for _, match := range matches {
-		if num, err := strconv.Atoi(match[1]); err == nil && !seen[num] {
-			refs = append(refs, num)
-			seen[num] = true
+// resolvedByCommits maps each issue closed by a keyword reference
+// ("fixes #3") to the most recent commit that closes it. Commits are
+// expected newest first, as git log lists them.
+func resolvedByCommits(commits []CommitInfo) map[int]string {
+	resolved := make(map[int]string)
+	for _, c := range commits {
+		for _, ref := range commitRefs.Match(c.Subject + " " + c.Body) {
+			if _, ok := resolved[ref.Number]; ref.Resolves && !ok {
+				resolved[ref.Number] = c.Hash
+			}
 		}
 	}
-	return refs
+	return resolved
+}
+
+// percentChange describes the change from prev to cur, e.g. "+20%".
+// Growth from zero has no percentage, so the difference is shown instead.
+func percentChange(cur, prev int) string {
+	if cur == prev {
+		return "±0%"
+	}
+	if prev == 0 {
+		return fmt.Sprintf("%+d", cur)
+	}
+	return fmt.Sprintf("%+.0f%%", float64(cur-prev)*100/float64(prev))
+}
+
+// comparisonLines returns the metric lines comparing data with the
+// previous period, or nil if there is no previous period.
+func comparisonLines(data *ReportData) []string {
+	if data.Previous == nil {
+		return nil
+	}
+	cur, prev := data.metrics(), data.Previous.metrics()
+	return []string{
+		i18n.T("report.compare.commits", cur.Commits, prev.Commits, percentChange(cur.Commits, prev.Commits)),
+		i18n.T("report.compare.closed", cur.IssuesClosed, prev.IssuesClosed, percentChange(cur.IssuesClosed, prev.IssuesClosed)),
+		i18n.T("report.compare.files", cur.FilesChanged, prev.FilesChanged, percentChange(cur.FilesChanged, prev.FilesChanged)),
+	}
+}
+
+// issueCommitNote is the note shown after an issue in the report: the
+// commit that resolved it, or that no commit refers to it.
+func issueCommitNote(data *ReportData, number int) string {
+	if hash, ok := data.ResolvedBy[number]; ok {
+		return i18n.T("report.resolved_by", hash)
+	}
+	if len(data.IssueLinks[number]) == 0 {
+		return i18n.T("report.no_commits")
+	}
+	return ""
 }
 
 // linkCommitsToIssues creates a mapping from issue numbers to related commits.
@@ -469,19 +740,29 @@ func getRelatedIssues(allIssues []*issue.Issue, links map[int][]CommitInfo) []*i
 func formatReportMarkdown(data *ReportData) string {
 	var sb strings.Builder
 
-	sb.WriteString("# 작업 보고서\n")
-	sb.WriteString(fmt.Sprintf("> 기간: %s\n\n", data.Period))
+	sb.WriteString("# " + i18n.T("report.title") + "\n")
+	sb.WriteString("> " + i18n.T("report.period", data.Period) + "\n\n")
 
 	// Summary section
 	if data.Summary != "" {
-		sb.WriteString("## 요약\n")
+		sb.WriteString("## " + i18n.T("report.summary") + "\n")
 		sb.WriteString(data.Summary + "\n\n")
 	}
 
+	// Comparison section
+	if lines := comparisonLines(data); lines != nil {
+		sb.WriteString("## " + i18n.T("report.compare", data.Previous.Period) + "\n")
+		for _, line := range lines {
+			sb.WriteString("- " + line + "\n")
+		}
+		sb.WriteString("\n")
+	}
+
 	// Commits section
 	if len(data.Commits) > 0 {
-		sb.WriteString(fmt.Sprintf("## 커밋 (%d건)\n", len(data.Commits)))
-		sb.WriteString("| 해시 | 메시지 | 관련 이슈 |\n")
+		sb.WriteString("## " + i18n.T("report.commits", len(data.Commits)) + "\n")
+		sb.WriteString(fmt.Sprintf("| %s | %s | %s |\n",
+			i18n.T("report.col.hash"), i18n.T("report.col.message"), i18n.T("report.col.issues")))
 		sb.WriteString("|------|--------|----------|\n")
 
 		for _, c := range data.Commits {
@@ -503,7 +784,7 @@ func formatReportMarkdown(data *ReportData) string {
 
 	// Issues section
 	if len(data.Issues) > 0 {
-		sb.WriteString("## 이슈 진행 상황\n")
+		sb.WriteString("## " + i18n.T("report.issues") + "\n")
 
 		// Group by state
 		byState := make(map[issue.State][]*issue.Issue)
@@ -513,10 +794,17 @@ func formatReportMarkdown(data *ReportData) string {
 
 		stateOrder := []issue.State{issue.StateDone, issue.StateWip, issue.StateOpen, issue.StateClosed}
 		stateNames := map[issue.State]string{
-			issue.StateDone:   "완료 (done)",
-			issue.StateWip:    "진행 중 (wip)",
-			issue.StateOpen:   "신규 (open)",
-			issue.StateClosed: "취소 (closed)",
+			issue.StateDone:   i18n.T("report.state.done"),
+			issue.StateWip:    i18n.T("report.state.wip"),
+			issue.StateOpen:   i18n.T("report.state.open"),
+			issue.StateClosed: i18n.T("report.state.closed"),
+		}
+		// States added in .zap.yml follow the built-in ones, under their name
+		for _, state := range issue.AllStates() {
+			if _, ok := stateNames[state]; !ok {
+				stateOrder = append(stateOrder, state)
+				stateNames[state] = string(state)
+			}
 		}
 
 		for _, state := range stateOrder {
@@ -527,7 +815,11 @@ func formatReportMarkdown(data *ReportData) string {
 
 			sb.WriteString(fmt.Sprintf("### %s\n", stateNames[state]))
 			for _, iss := range issues {
-				sb.WriteString(fmt.Sprintf("- #%d: %s\n", iss.Number, iss.Title))
+				sb.WriteString(fmt.Sprintf("- #%d: %s", iss.Number, iss.Title))
+				if note := issueCommitNote(data, iss.Number); note != "" {
+					sb.WriteString(" _(" + note + ")_")
+				}
+				sb.WriteString("\n")
 			}
 			sb.WriteString("\n")
 		}
@@ -535,10 +827,10 @@ func formatReportMarkdown(data *ReportData) string {
 
 	// File stats section
 	if data.FileStats != nil && len(data.FileStats.Files) > 0 {
-		sb.WriteString("## 파일 변경 통계\n")
-		sb.WriteString(fmt.Sprintf("- 추가: %d개 파일\n", data.FileStats.Added))
-		sb.WriteString(fmt.Sprintf("- 수정: %d개 파일\n", data.FileStats.Modified))
-		sb.WriteString(fmt.Sprintf("- 삭제: %d개 파일\n", data.FileStats.Deleted))
+		sb.WriteString("## " + i18n.T("report.files") + "\n")
+		sb.WriteString("- " + i18n.T("report.files.added", data.FileStats.Added) + "\n")
+		sb.WriteString("- " + i18n.T("report.files.modified", data.FileStats.Modified) + "\n")
+		sb.WriteString("- " + i18n.T("report.files.deleted", data.FileStats.Deleted) + "\n")
 
 		// Find major change area
 		dirCounts := make(map[string]int)
@@ -552,33 +844,70 @@ func formatReportMarkdown(data *ReportData) string {
 			var maxDir string
 			var maxCount int
 			for dir, count := range dirCounts {
-				if count > maxCount {
+				// Ties go to the first directory by name, not map order
+				if count > maxCount || (count == maxCount && dir < maxDir) {
 					maxDir = dir
 					maxCount = count
 				}
 			}
-			sb.WriteString(fmt.Sprintf("- 주요 변경 영역: %s\n", maxDir))
+			sb.WriteString("- " + i18n.T("report.files.main_area", maxDir) + "\n")
 		}
 	}
 
 	return sb.String()
 }
 
+// reportHTMLStyle is the inline stylesheet of HTML reports.
+const reportHTMLStyle = `body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; max-width: 860px; margin: 2em auto; padding: 0 1em; color: #24292f; line-height: 1.5; }
+h1 { border-bottom: 1px solid #d0d7de; padding-bottom: .3em; }
+h2 { margin-top: 1.5em; border-bottom: 1px solid #d0d7de; padding-bottom: .2em; }
+blockquote { margin: 0; padding: 0 1em; color: #57606a; border-left: .25em solid #d0d7de; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #d0d7de; padding: 6px 12px; text-align: left; }
+th { background: #f6f8fa; }
+code { background: #f6f8fa; padding: .2em .4em; border-radius: 4px; }`
+
+// formatReportHTML renders the markdown report as a self-contained HTML document.
+// Raw HTML in the AI summary is not passed through.
+func formatReportHTML(data *ReportData) (string, error) {
+	var body bytes.Buffer
+	md := goldmark.New(goldmark.WithExtensions(extension.GFM))
+	if err := md.Convert([]byte(formatReportMarkdown(data)), &body); err != nil {
+		return "", fmt.Errorf("failed to render HTML: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	sb.WriteString(fmt.Sprintf("<title>%s - %s</title>\n", i18n.T("report.title"), html.EscapeString(data.Period)))
+	sb.WriteString("<style>\n" + reportHTMLStyle + "\n</style>\n</head>\n<body>\n")
+	sb.Write(body.Bytes())
+	sb.WriteString("</body>\n</html>\n")
+	return sb.String(), nil
+}
+
 // formatReportText formats report as plain text.
 func formatReportText(data *ReportData) string {
 	var sb strings.Builder
 
-	sb.WriteString("작업 보고서\n")
-	sb.WriteString(fmt.Sprintf("기간: %s\n", data.Period))
+	sb.WriteString(i18n.T("report.title") + "\n")
+	sb.WriteString(i18n.T("report.period", data.Period) + "\n")
 	sb.WriteString(strings.Repeat("=", 50) + "\n\n")
 
 	if data.Summary != "" {
-		sb.WriteString("요약:\n")
+		sb.WriteString(i18n.T("report.summary") + ":\n")
 		sb.WriteString(data.Summary + "\n\n")
 	}
 
+	if lines := comparisonLines(data); lines != nil {
+		sb.WriteString(i18n.T("report.compare", data.Previous.Period) + ":\n")
+		for _, line := range lines {
+			sb.WriteString("  " + line + "\n")
+		}
+		sb.WriteString("\n")
+	}
+
 	if len(data.Commits) > 0 {
-		sb.WriteString(fmt.Sprintf("커밋 (%d건):\n", len(data.Commits)))
+		sb.WriteString(i18n.T("report.commits", len(data.Commits)) + ":\n")
 		for _, c := range data.Commits {
 			refs := extractIssueRefs(c.Subject + " " + c.Body)
 			refStr := ""
@@ -595,17 +924,21 @@ func formatReportText(data *ReportData) string {
 	}
 
 	if len(data.Issues) > 0 {
-		sb.WriteString("이슈 진행 상황:\n")
+		sb.WriteString(i18n.T("report.issues") + ":\n")
 		for _, iss := range data.Issues {
-			sb.WriteString(fmt.Sprintf("  [%s] #%d: %s\n", iss.State, iss.Number, iss.Title))
+			sb.WriteString(fmt.Sprintf("  [%s] #%d: %s", iss.State, iss.Number, iss.Title))
+			if note := issueCommitNote(data, iss.Number); note != "" {
+				sb.WriteString(" (" + note + ")")
+			}
+			sb.WriteString("\n")
 		}
 		sb.WriteString("\n")
 	}
 
 	if data.FileStats != nil && len(data.FileStats.Files) > 0 {
-		sb.WriteString("파일 변경 통계:\n")
-		sb.WriteString(fmt.Sprintf("  추가: %d, 수정: %d, 삭제: %d\n",
-			data.FileStats.Added, data.FileStats.Modified, data.FileStats.Deleted))
+		sb.WriteString(i18n.T("report.files") + ":\n")
+		sb.WriteString("  " + i18n.T("report.files.summary",
+			data.FileStats.Added, data.FileStats.Modified, data.FileStats.Deleted) + "\n")
 	}
 
 	return sb.String()
@@ -613,13 +946,29 @@ func formatReportText(data *ReportData) string {
 
 // ReportJSON is the JSON output structure.
 type ReportJSON struct {
-	Period    string        `json:"period"`
-	Since     string        `json:"since"`
-	Until     string        `json:"until"`
-	Summary   string        `json:"summary,omitempty"`
-	Commits   []CommitJSON  `json:"commits"`
-	Issues    []IssueJSON   `json:"issues"`
-	FileStats FileStatsJSON `json:"file_stats"`
+	Period    string         `json:"period"`
+	Since     string         `json:"since"`
+	Until     string         `json:"until"`
+	Summary   string         `json:"summary,omitempty"`
+	Commits   *[]CommitJSON  `json:"commits,omitempty"`    // nil only when left out
+	Issues    *[]IssueJSON   `json:"issues,omitempty"`     // nil only when left out
+	FileStats *FileStatsJSON `json:"file_stats,omitempty"` // nil only when left out
+	Compare   *CompareJSON   `json:"compare,omitempty"`
+}
+
+// CompareJSON is the JSON structure for the comparison with the previous period.
+type CompareJSON struct {
+	PreviousPeriod string    `json:"previous_period"`
+	Commits        DeltaJSON `json:"commits"`
+	IssuesClosed   DeltaJSON `json:"issues_closed"`
+	FilesChanged   DeltaJSON `json:"files_changed"`
+}
+
+// DeltaJSON is the JSON structure for one compared metric.
+type DeltaJSON struct {
+	Current  int    `json:"current"`
+	Previous int    `json:"previous"`
+	Change   string `json:"change"`
 }
 
 // CommitJSON is the JSON structure for a commit.
@@ -633,11 +982,13 @@ type CommitJSON struct {
 
 // IssueJSON is the JSON structure for an issue.
 type IssueJSON struct {
-	Number  int      `json:"number"`
-	Title   string   `json:"title"`
-	State   string   `json:"state"`
-	Labels  []string `json:"labels,omitempty"`
-	Commits []string `json:"commits,omitempty"`
+	Number     int      `json:"number"`
+	Title      string   `json:"title"`
+	State      string   `json:"state"`
+	Labels     []string `json:"labels,omitempty"`
+	Commits    []string `json:"commits,omitempty"`
+	NoCommits  bool     `json:"no_linked_commits,omitempty"`
+	ResolvedBy string   `json:"resolved_by,omitempty"`
 }
 
 // FileStatsJSON is the JSON structure for file stats.
@@ -658,6 +1009,7 @@ func formatReportJSON(data *ReportData) ([]byte, error) {
 	}
 
 	// Commits
+	var commits []CommitJSON
 	for _, c := range data.Commits {
 		cj := CommitJSON{
 			Hash:    c.Hash,
@@ -666,10 +1018,14 @@ func formatReportJSON(data *ReportData) ([]byte, error) {
 			Date:    c.Date,
 			Issues:  extractIssueRefs(c.Subject + " " + c.Body),
 		}
-		report.Commits = append(report.Commits, cj)
+		commits = append(commits, cj)
+	}
+	if !data.OmitCommits {
+		report.Commits = &commits
 	}
 
 	// Issues
+	var issues []IssueJSON
 	for _, iss := range data.Issues {
 		ij := IssueJSON{
 			Number: iss.Number,
@@ -683,16 +1039,37 @@ func formatReportJSON(data *ReportData) ([]byte, error) {
 				ij.Commits = append(ij.Commits, c.Hash)
 			}
 		}
-		report.Issues = append(report.Issues, ij)
+		ij.NoCommits = len(ij.Commits) == 0
+		ij.ResolvedBy = data.ResolvedBy[iss.Number]
+		issues = append(issues, ij)
+	}
+	if !data.OmitIssues {
+		report.Issues = &issues
 	}
 
-	// File stats
-	if data.FileStats != nil {
-		report.FileStats = FileStatsJSON{
-			Added:    data.FileStats.Added,
-			Modified: data.FileStats.Modified,
-			Deleted:  data.FileStats.Deleted,
-			Files:    data.FileStats.Files,
+	// File stats go with either section
+	if !data.OmitCommits && !data.OmitIssues {
+		report.FileStats = &FileStatsJSON{}
+		if data.FileStats != nil {
+			*report.FileStats = FileStatsJSON{
+				Added:    data.FileStats.Added,
+				Modified: data.FileStats.Modified,
+				Deleted:  data.FileStats.Deleted,
+				Files:    data.FileStats.Files,
+			}
+		}
+	}
+
+	if data.Previous != nil {
+		cur, prev := data.metrics(), data.Previous.metrics()
+		delta := func(c, p int) DeltaJSON {
+			return DeltaJSON{Current: c, Previous: p, Change: percentChange(c, p)}
+		}
+		report.Compare = &CompareJSON{
+			PreviousPeriod: data.Previous.Period,
+			Commits:        delta(cur.Commits, prev.Commits),
+			IssuesClosed:   delta(cur.IssuesClosed, prev.IssuesClosed),
+			FilesChanged:   delta(cur.FilesChanged, prev.FilesChanged),
 		}
 	}
 
@@ -700,20 +1077,40 @@ func formatReportJSON(data *ReportData) ([]byte, error) {
 }
 
 // generateReportSummary generates an AI summary of the report.
-func generateReportSummary(data *ReportData) (string, error) {
-	client, err := getAIClient(reportAI)
+func generateReportSummary(dir string, data *ReportData) (string, error) {
+	client, err := getAIClient(dir, reportAI, reportAIModel)
 	if err != nil {
 		return "", err
 	}
 
 	fmt.Fprintf(os.Stderr, "🤖 Using %s to generate summary...\n", client.Name())
 
-	// Build context for AI
+	systemPrompt, userPrompt := buildReportPrompt(data)
+	if reportShowPrompt {
+		fmt.Fprintf(os.Stderr, "--- system prompt ---\n%s\n--- user prompt ---\n%s\n--- end of prompt ---\n", systemPrompt, userPrompt)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), reportTimeout)
+	defer cancel()
+
+	resp, err := client.Complete(ctx, &ai.Request{
+		System: systemPrompt,
+		Prompt: userPrompt,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(resp.Content), nil
+}
+
+// buildReportPrompt returns the system and user prompt for the AI summary.
+func buildReportPrompt(data *ReportData) (system, user string) {
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("기간: %s\n\n", data.Period))
+	sb.WriteString(i18n.T("report.period", data.Period) + "\n\n")
 
 	if len(data.Commits) > 0 {
-		sb.WriteString("## 커밋 목록\n")
+		sb.WriteString("## " + i18n.T("report.ai.commits") + "\n")
 		for _, c := range data.Commits {
 			refs := extractIssueRefs(c.Subject + " " + c.Body)
 			refStr := ""
@@ -730,38 +1127,18 @@ func generateReportSummary(data *ReportData) (string, error) {
 	}
 
 	if len(data.Issues) > 0 {
-		sb.WriteString("## 이슈 상태\n")
+		sb.WriteString("## " + i18n.T("report.ai.issues") + "\n")
 		for _, iss := range data.Issues {
 			sb.WriteString(fmt.Sprintf("- #%d [%s]: %s\n", iss.Number, iss.State, iss.Title))
 		}
 	}
 
-	systemPrompt := `당신은 개발팀의 작업 보고서를 작성하는 테크니컬 라이터입니다.
-주어진 커밋과 이슈 정보를 바탕으로 팀 공유용 요약을 작성하세요.
-
-규칙:
-- 한국어로 작성
-- 2-3문장으로 핵심 성과 요약
-- 주요 변경 사항 강조
-- 전문적이고 간결한 톤 유지
-- 추가 설명이나 코멘트 없이 요약만 출력`
-
-	userPrompt := fmt.Sprintf(`다음은 %s 동안의 작업 내역입니다.
-
-%s
-
-위 내용을 바탕으로 팀 공유용 보고서 요약을 작성해주세요.`, data.Period, sb.String())
-
-	ctx, cancel := context.WithTimeout(context.Background(), reportTimeout)
-	defer cancel()
-
-	resp, err := client.Complete(ctx, &ai.Request{
-		System: systemPrompt,
-		Prompt: userPrompt,
-	})
-	if err != nil {
-		return "", err
+	if lines := comparisonLines(data); lines != nil {
+		sb.WriteString("\n## " + i18n.T("report.compare", data.Previous.Period) + "\n")
+		for _, line := range lines {
+			sb.WriteString("- " + line + "\n")
+		}
 	}
 
-	return strings.TrimSpace(resp.Content), nil
+	return i18n.T("report.ai.system"), i18n.T("report.ai.user", data.Period, sb.String())
 }
@@ -0,0 +1,375 @@
+package cli
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+func TestFormatReportHTML(t *testing.T) {
+	data := &ReportData{
+		Period:  "2026-01-12 ~ 2026-01-18",
+		Summary: "Shipped <script>alert(1)</script> export",
+		Commits: []CommitInfo{{Hash: "abc1234", Subject: "Add CSV export (#3)"}},
+		Issues: []*issue.Issue{
+			{Number: 3, Title: "CSV export", State: issue.StateDone},
+		},
+		IssueLinks: map[int][]CommitInfo{3: {{Hash: "abc1234"}}},
+	}
+
+	out, err := formatReportHTML(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"<!DOCTYPE html>",
+		"<style>",
+		"<title>작업 보고서 - 2026-01-12 ~ 2026-01-18</title>",
+		"<td>abc1234</td>",
+		"<li>#3: CSV export</li>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("HTML report missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "<script>") {
+		t.Error("raw HTML from the summary was passed through")
+	}
+}
+
+func TestReportUntilBoundary(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data not available")
+	}
+	saved := time.Local
+	time.Local = ny
+	defer func() { time.Local = saved }()
+
+	// 2025-03-09 is only 23 hours long in New York
+	since, until, err := (&DateFilter{Since: "2025-03-09", Until: "2025-03-09"}).GetDateRange()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2025, 3, 10, 0, 0, 0, 0, ny); !until.Equal(want) {
+		t.Errorf("until = %v, want next local midnight %v", until, want)
+	}
+
+	at := func(day, hour, minute int) time.Time { return time.Date(2025, 3, day, hour, minute, 0, 0, ny).UTC() }
+	lateEdit := at(9, 23, 30)
+	issues := []*issue.Issue{
+		{Number: 1, CreatedAt: at(1, 9, 0), UpdatedAt: lateEdit},      // Relabeled late in the day
+		{Number: 2, CreatedAt: at(1, 9, 0), UpdatedAt: at(10, 0, 0)},  // First moment of the next day
+		{Number: 3, CreatedAt: at(9, 0, 0), UpdatedAt: at(11, 12, 0)}, // Created at the first moment
+		{Number: 4, CreatedAt: at(1, 9, 0), UpdatedAt: at(8, 23, 59)},
+	}
+	var got []int
+	for _, iss := range issuesInPeriod(issues, since, until) {
+		got = append(got, iss.Number)
+	}
+	if !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("issuesInPeriod() = %v, want [1 3]", got)
+	}
+
+	args := gitDateRangeArgs(since, until)
+	want := []string{"--since=2025-03-09T00:00:00-05:00", "--until=2025-03-09T23:59:59-04:00"}
+	if !slices.Equal(args, want) {
+		t.Errorf("gitDateRangeArgs() = %v, want %v", args, want)
+	}
+}
+
+func TestReportNoLinkedCommits(t *testing.T) {
+	data := &ReportData{
+		Period: "2026-01-12 ~ 2026-01-18",
+		Issues: []*issue.Issue{
+			{Number: 3, Title: "CSV export", State: issue.StateDone},
+			{Number: 4, Title: "Relabel", State: issue.StateOpen},
+		},
+		IssueLinks: map[int][]CommitInfo{3: {{Hash: "abc1234"}}},
+	}
+
+	md := formatReportMarkdown(data)
+	if !strings.Contains(md, "- #4: Relabel _(") || strings.Contains(md, "- #3: CSV export _(") {
+		t.Errorf("markdown should mark only #4 as having no linked commits:\n%s", md)
+	}
+
+	out, err := formatReportJSON(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(out), `"no_linked_commits": true`); n != 1 {
+		t.Errorf("JSON marks %d issues without commits, want 1:\n%s", n, out)
+	}
+}
+
+func TestReportResolvedBy(t *testing.T) {
+	saved := commitRefs
+	defer func() { commitRefs = saved }()
+	var err error
+	if commitRefs, err = issue.NewRefMatcher(`ISSUE-(\d+)`, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	commits := []CommitInfo{
+		{Hash: "ccc3333", Subject: "Fix ISSUE-4 for good"},
+		{Hash: "bbb2222", Subject: "Closes #3", Body: "Refs #5"},
+		{Hash: "aaa1111", Subject: "Fixes ISSUE-4"},
+	}
+	resolved := resolvedByCommits(commits)
+	want := map[int]string{3: "bbb2222", 4: "ccc3333"}
+	if len(resolved) != len(want) || resolved[3] != want[3] || resolved[4] != want[4] {
+		t.Errorf("resolvedByCommits() = %v, want %v", resolved, want)
+	}
+
+	issues := []*issue.Issue{
+		{Number: 3, Title: "CSV export", State: issue.StateDone},
+		{Number: 5, Title: "Docs", State: issue.StateOpen},
+	}
+	data := &ReportData{
+		Period:     "2026-01-12 ~ 2026-01-18",
+		Commits:    commits,
+		Issues:     issues,
+		IssueLinks: linkCommitsToIssues(commits, issues),
+		ResolvedBy: resolved,
+	}
+	text := formatReportText(data)
+	for _, want := range []string{"#3: CSV export (", "bbb2222", "#5: Docs\n"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("text report missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestSortReportData(t *testing.T) {
+	newData := func(commits []CommitInfo, numbers ...int) *ReportData {
+		var issues []*issue.Issue
+		for _, n := range numbers {
+			issues = append(issues, &issue.Issue{Number: n, Title: "Issue", State: issue.StateOpen})
+		}
+		return &ReportData{
+			Period:     "2026-01-12 ~ 2026-01-18",
+			Commits:    commits,
+			Issues:     issues,
+			IssueLinks: linkCommitsToIssues(commits, issues),
+			ResolvedBy: resolvedByCommits(commits),
+			FileStats:  &FileStats{Modified: 2, Files: []string{"b/x.go", "a/y.go"}},
+		}
+	}
+
+	// The same commits and issues as git or the store might list them
+	a := newData([]CommitInfo{
+		{Hash: "bbb2222", Subject: "Fixes #2", Date: "2026-01-13"},
+		{Hash: "aaa1111", Subject: "Fixes #2", Date: "2026-01-13"},
+		{Hash: "ccc3333", Subject: "Refs #1", Date: "2026-01-12"},
+	}, 2, 1)
+	b := newData([]CommitInfo{
+		{Hash: "ccc3333", Subject: "Refs #1", Date: "2026-01-12"},
+		{Hash: "aaa1111", Subject: "Fixes #2", Date: "2026-01-13"},
+		{Hash: "bbb2222", Subject: "Fixes #2", Date: "2026-01-13"},
+	}, 1, 2)
+
+	sortReportData(a)
+	sortReportData(b)
+	for _, format := range []func(*ReportData) string{formatReportMarkdown, formatReportText} {
+		if outA, outB := format(a), format(b); outA != outB {
+			t.Errorf("sorted reports differ:\n%s\n---\n%s", outA, outB)
+		}
+	}
+
+	var hashes []string
+	for _, c := range a.Commits {
+		hashes = append(hashes, c.Hash)
+	}
+	if want := []string{"aaa1111", "bbb2222", "ccc3333"}; !slices.Equal(hashes, want) {
+		t.Errorf("commit order = %v, want %v", hashes, want)
+	}
+	if a.ResolvedBy[2] != "aaa1111" {
+		t.Errorf("ResolvedBy[2] = %q, want aaa1111", a.ResolvedBy[2])
+	}
+	if a.Issues[0].Number != 1 || a.FileStats.Files[0] != "a/y.go" {
+		t.Errorf("issues or files not sorted: #%d, %v", a.Issues[0].Number, a.FileStats.Files)
+	}
+}
+
+func TestPreviousPeriodStart(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2026, 1, d, h, 0, 0, 0, time.Local) }
+	tests := []struct {
+		name         string
+		since, until time.Time
+		want         time.Time
+	}{
+		{"whole week", day(12, 0), day(19, 0), day(5, 0)},
+		{"partial last day", day(12, 0), day(18, 15), day(5, 0)},
+		{"single day", day(12, 0), day(13, 0), day(11, 0)},
+	}
+	for _, tt := range tests {
+		if got := previousPeriodStart(tt.since, tt.until); !got.Equal(tt.want) {
+			t.Errorf("%s: previousPeriodStart() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestReportCompare(t *testing.T) {
+	for _, tt := range []struct {
+		cur, prev int
+		want      string
+	}{
+		{12, 10, "+20%"}, {5, 10, "-50%"}, {3, 3, "±0%"}, {4, 0, "+4"}, {0, 0, "±0%"},
+	} {
+		if got := percentChange(tt.cur, tt.prev); got != tt.want {
+			t.Errorf("percentChange(%d, %d) = %q, want %q", tt.cur, tt.prev, got, tt.want)
+		}
+	}
+
+	since := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+	closedIn := since.Add(30 * time.Hour)
+	closedBefore := since.Add(-30 * time.Hour)
+	data := &ReportData{
+		Period:  "2026-01-12 ~ 2026-01-18",
+		Since:   since,
+		Until:   since.AddDate(0, 0, 7).Add(-time.Nanosecond),
+		Commits: []CommitInfo{{Hash: "aaa1111"}, {Hash: "bbb2222"}, {Hash: "ccc3333"}},
+		Issues: []*issue.Issue{
+			{Number: 3, State: issue.StateDone, ClosedAt: &closedIn},
+			{Number: 4, State: issue.StateDone, ClosedAt: &closedBefore}, // Referenced by a commit only
+		},
+		FileStats: &FileStats{Files: []string{"a.go", "b.go"}},
+		Previous: &ReportData{
+			Period:  "2026-01-05 ~ 2026-01-11",
+			Commits: []CommitInfo{{Hash: "ddd4444"}, {Hash: "eee5555"}},
+		},
+	}
+
+	md := formatReportMarkdown(data)
+	for _, want := range []string{"2026-01-05 ~ 2026-01-11", "3건 (이전 2건, +50%)", "1건 (이전 0건, +1)", "2개 (이전 0개, +2)"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown report missing %q:\n%s", want, md)
+		}
+	}
+
+	out, err := formatReportJSON(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), `"previous_period": "2026-01-05 ~ 2026-01-11"`) || !strings.Contains(string(out), `"change": "+50%"`) {
+		t.Errorf("JSON report missing comparison:\n%s", out)
+	}
+
+	data.Previous = nil
+	if out, _ := formatReportJSON(data); strings.Contains(string(out), `"compare"`) {
+		t.Errorf("JSON report has a comparison without --compare:\n%s", out)
+	}
+}
+
+func TestBuildReportPrompt(t *testing.T) {
+	data := &ReportData{
+		Period:  "2026-01-12 ~ 2026-01-18",
+		Commits: []CommitInfo{{Hash: "abc1234", Subject: "Add CSV export (#3)"}},
+		Issues:  []*issue.Issue{{Number: 3, Title: "CSV export", State: issue.StateDone}},
+	}
+
+	system, user := buildReportPrompt(data)
+	if system == "" {
+		t.Error("system prompt is empty")
+	}
+	for _, want := range []string{"2026-01-12 ~ 2026-01-18", "- abc1234: Add CSV export (#3) (#3)", "- #3 [done]: CSV export"} {
+		if !strings.Contains(user, want) {
+			t.Errorf("user prompt missing %q:\n%s", want, user)
+		}
+	}
+}
+
+func TestTrimReportSections(t *testing.T) {
+	newData := func() *ReportData {
+		return &ReportData{
+			Period:     "2026-01-12 ~ 2026-01-18",
+			Summary:    "요약",
+			Commits:    []CommitInfo{{Hash: "aaa1111", Subject: "Fix parser (fixes #3)"}},
+			Issues:     []*issue.Issue{{Number: 3, Title: "Parser bug", State: issue.StateDone}},
+			IssueLinks: map[int][]CommitInfo{3: {{Hash: "aaa1111"}}},
+			ResolvedBy: map[int]string{3: "aaa1111"},
+			FileStats:  &FileStats{Modified: 1, Files: []string{"parser.go"}},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		issues, commits   bool
+		want, notWant     []string
+		jsonWant, jsonNot []string
+	}{
+		{
+			name:     "issues only",
+			issues:   true,
+			want:     []string{"요약", "#3: Parser bug", "aaa1111"},
+			notWant:  []string{"Fix parser", "parser.go"},
+			jsonWant: []string{`"summary"`, `"issues"`, `"resolved_by": "aaa1111"`},
+			jsonNot:  []string{`"subject"`, `"file_stats"`},
+		},
+		{
+			name:     "commits only",
+			commits:  true,
+			want:     []string{"요약", "Fix parser"},
+			notWant:  []string{"Parser bug"},
+			jsonWant: []string{`"summary"`, `"commits"`},
+			jsonNot:  []string{`"title"`, `"file_stats"`},
+		},
+		{
+			name:     "all sections",
+			want:     []string{"Parser bug", "Fix parser"},
+			jsonWant: []string{`"commits"`, `"issues"`, `"file_stats"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := newData()
+			trimReportSections(data, tt.issues, tt.commits)
+
+			for _, out := range []string{formatReportMarkdown(data), formatReportText(data)} {
+				for _, want := range tt.want {
+					if !strings.Contains(out, want) {
+						t.Errorf("report missing %q:\n%s", want, out)
+					}
+				}
+				for _, notWant := range tt.notWant {
+					if strings.Contains(out, notWant) {
+						t.Errorf("report has %q:\n%s", notWant, out)
+					}
+				}
+			}
+
+			out, err := formatReportJSON(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.jsonWant {
+				if !strings.Contains(string(out), want) {
+					t.Errorf("JSON report missing %q:\n%s", want, out)
+				}
+			}
+			for _, notWant := range tt.jsonNot {
+				if strings.Contains(string(out), notWant) {
+					t.Errorf("JSON report has %q:\n%s", notWant, out)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatReportJSONEmptySections(t *testing.T) {
+	// Empty sections keep their keys so consumers need not check for them
+	out, err := formatReportJSON(&ReportData{Period: "2026-01-12 ~ 2026-01-18"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"commits": null`, `"issues": null`, `"file_stats": {`} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("JSON report missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/itda-work/zap/internal/issue"
+)
+
+// reportWatcher keeps the last AI summary so it is only regenerated when
+// the underlying commits or issues change. A failed summary is not retried
+// until then either; its warning stays on screen instead.
+type reportWatcher struct {
+	store       *issue.Store
+	args        []string
+	fingerprint string
+	summary     string
+	aiWarning   string
+	summarize   func(dir string, data *ReportData) (string, error)
+}
+
+// reportWatchDebounce is the default wait for issue changes to settle
+// before the report is regenerated, which takes longer than a list redraw.
+const reportWatchDebounce = 500 * time.Millisecond
+
+// runReportWatch regenerates the report on issue file changes and every
+// --interval, redrawing the screen each time.
+func runReportWatch(dir string, store *issue.Store, args []string) error {
+	if reportOutput != "" {
+		return fmt.Errorf("--watch cannot be used with --output")
+	}
+	if reportInterval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(dir); err != nil {
+		return fmt.Errorf("failed to watch directory: %w", err)
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	rw := &reportWatcher{store: store, args: args, summarize: generateReportSummary}
+	rw.render()
+
+	debounce := time.NewTimer(0)
+	debounce.Stop()
+	defer debounce.Stop()
+	debounceDelay := getWatchDebounce(dir, reportWatchDebounce)
+
+	ticker := time.NewTicker(reportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sigChan:
+			clearScreen()
+			fmt.Println("Report watch exited.")
+			return nil
+
+		case <-ticker.C:
+			rw.render()
+
+		case <-debounce.C:
+			rw.render()
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if strings.HasSuffix(event.Name, ".md") {
+				debounce.Reset(debounceDelay)
+			}
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "Watch error: %v\n", err)
+		}
+	}
+}
+
+// render rebuilds the report and redraws the screen
+func (rw *reportWatcher) render() {
+	data, err := buildReport(rw.store, rw.args)
+	if err != nil {
+		clearScreen()
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return
+	}
+
+	if !reportNoAI && hasReportContent(data) {
+		fingerprint := reportFingerprint(data)
+		if fingerprint != rw.fingerprint {
+			rw.fingerprint = fingerprint
+			rw.summary, rw.aiWarning = "", ""
+			summary, aiErr := rw.summarize(rw.store.BaseDir(), data)
+			if aiErr != nil {
+				rw.aiWarning = fmt.Sprintf("⚠️  Failed to generate AI summary: %v", aiErr)
+			} else {
+				rw.summary = summary
+			}
+		}
+		data.Summary = rw.summary
+	}
+	trimReportSections(data, reportIssuesOnly, reportCommitsOnly)
+
+	output, err := formatReport(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return
+	}
+
+	// Shown inside the frame: anything printed before clearScreen is wiped
+	clearScreen()
+	fmt.Println(output)
+	if rw.aiWarning != "" {
+		fmt.Fprintln(os.Stderr, rw.aiWarning)
+	}
+	fmt.Println(colorize(fmt.Sprintf("Last updated: %s · refresh every %s · Ctrl+C to exit",
+		time.Now().Format("15:04:05"), reportInterval), colorGray))
+}
+
+// reportFingerprint identifies the commits and issue states in a report,
+// so the AI summary is only regenerated when they change.
+func reportFingerprint(data *ReportData) string {
+	var sb strings.Builder
+	for _, c := range data.Commits {
+		sb.WriteString(c.Hash)
+		sb.WriteString("\n")
+	}
+	for _, iss := range data.Issues {
+		fmt.Fprintf(&sb, "#%d %s %s %s\n", iss.Number, iss.State, iss.UpdatedAt.UTC().Format(time.RFC3339), iss.Title)
+	}
+	return sb.String()
+}
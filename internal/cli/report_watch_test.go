@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+func TestReportWatcherAIFailure(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "001-first.md")
+	write := func(title string) {
+		content := "---\nnumber: 1\ntitle: " + title + "\nstate: open\ncreated_at: 2026-01-01T00:00:00Z\nupdated_at: 2026-01-01T00:00:00Z\n---\n"
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("First")
+
+	calls := 0
+	fail := true
+	rw := &reportWatcher{
+		store: issue.NewStore(dir),
+		args:  []string{"1"},
+		summarize: func(string, *ReportData) (string, error) {
+			calls++
+			if fail {
+				return "", errors.New("provider offline")
+			}
+			return "All good", nil
+		},
+	}
+	// render redraws the screen on stdout
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := os.Stdout
+	os.Stdout = devNull
+	t.Cleanup(func() {
+		os.Stdout = saved
+		devNull.Close()
+	})
+	render := rw.render
+
+	render()
+	if calls != 1 || !strings.Contains(rw.aiWarning, "provider offline") {
+		t.Fatalf("after failure: calls = %d, warning = %q", calls, rw.aiWarning)
+	}
+
+	// Unchanged data must not ask the provider again on the next tick
+	render()
+	if calls != 1 || rw.aiWarning == "" {
+		t.Errorf("unchanged report: calls = %d, warning = %q", calls, rw.aiWarning)
+	}
+
+	// A change retries and clears the warning once the summary succeeds
+	fail = false
+	write("First, renamed")
+	render()
+	if calls != 2 || rw.aiWarning != "" || rw.summary != "All good" {
+		t.Errorf("changed report: calls = %d, warning = %q, summary = %q", calls, rw.aiWarning, rw.summary)
+	}
+}
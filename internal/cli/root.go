@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/itda-work/zap/internal/config"
+	"github.com/itda-work/zap/internal/i18n"
 	"github.com/itda-work/zap/internal/issue"
 	"github.com/itda-work/zap/internal/project"
 	"github.com/spf13/cobra"
@@ -30,6 +32,28 @@ func init() {
 	// 글로벌 플래그 설정
 	rootCmd.PersistentFlags().StringP("dir", "d", ".issues", "Issues directory path")
 	rootCmd.PersistentFlags().StringArrayP("project", "C", nil, "Run as if zap was started in <path> (can be used multiple times)")
+	rootCmd.PersistentFlags().BoolVar(&plainOutput, "plain", false, "ASCII-only output without colors, box drawing or emoji")
+	rootCmd.PersistentFlags().IntVar(&outputWidth, "width", 0, "Fit output to N columns (0 = terminal width; also "+EnvWidth+")")
+	rootCmd.PersistentFlags().Bool("no-boundary", false, "Search for .issues in parent directories beyond the git repository root")
+
+	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		if plainOutput {
+			colorEnabled = false
+		}
+		loadStates(cmd)
+		startUpdateCheck(cmd)
+	}
+	rootCmd.PersistentPostRun = func(cmd *cobra.Command, args []string) {
+		printUpdateNotice()
+	}
+
+	// Help doesn't run PersistentPreRun, so load the states here too
+	defaultHelp := rootCmd.HelpFunc()
+	rootCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		loadStates(cmd)
+		setStateHelp()
+		defaultHelp(cmd, args)
+	})
 }
 
 // expandTilde expands ~ to home directory
@@ -69,9 +93,10 @@ func getProjectDir(cmd *cobra.Command) (string, error) {
 	return basePath, nil
 }
 
-// findIssuesDir walks up the directory tree to find .issues/ directory
+// findIssuesDir walks up the directory tree to find .issues/ directory,
+// stopping after boundary if it is set (the git repository root).
 // Returns (path, wasDiscovered)
-func findIssuesDir(startDir string) (string, bool) {
+func findIssuesDir(startDir, boundary string) (string, bool) {
 	issuesDir := ".issues"
 	currentDir := startDir
 
@@ -83,8 +108,8 @@ func findIssuesDir(startDir string) (string, bool) {
 	// Walk up to parent directories
 	for {
 		parent := filepath.Dir(currentDir)
-		if parent == currentDir {
-			// Reached root
+		if parent == currentDir || sameDir(currentDir, boundary) {
+			// Reached root or boundary
 			break
 		}
 		currentDir = parent
@@ -99,11 +124,39 @@ func findIssuesDir(startDir string) (string, bool) {
 	return filepath.Join(startDir, issuesDir), false
 }
 
-// getIssuesDirWithDiscovery returns (path, wasDiscovered, error)
+// sameDir reports whether a and b name the same directory, following
+// symlinks (git prints the resolved repository root).
+func sameDir(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	if ra, err := filepath.EvalSymlinks(a); err == nil {
+		a = ra
+	}
+	if rb, err := filepath.EvalSymlinks(b); err == nil {
+		b = rb
+	}
+	return filepath.Clean(a) == filepath.Clean(b)
+}
+
+// getIssuesDirWithDiscovery returns (path, wasDiscovered, error).
+// If the upward search stopped at the git root without finding .issues,
+// the boundary is reported on stderr.
 func getIssuesDirWithDiscovery(cmd *cobra.Command) (string, bool, error) {
+	path, discovered, stoppedAt, err := discoverIssuesDir(cmd)
+	if stoppedAt != "" {
+		fmt.Fprintf(os.Stderr, "info: No .issues found up to git root %s (use --no-boundary to search further)\n", stoppedAt)
+	}
+	return path, discovered, err
+}
+
+// discoverIssuesDir resolves the issues directory like
+// getIssuesDirWithDiscovery, without printing anything. stoppedAt is the
+// git root if the search ended there without finding .issues.
+func discoverIssuesDir(cmd *cobra.Command) (path string, discovered bool, stoppedAt string, err error) {
 	projectDir, err := getProjectDir(cmd)
 	if err != nil {
-		return "", false, err
+		return "", false, "", err
 	}
 
 	issuesDir, _ := cmd.Flags().GetString("dir")
@@ -111,19 +164,32 @@ func getIssuesDirWithDiscovery(cmd *cobra.Command) (string, bool, error) {
 	// If -C or -d flags explicitly set, don't do walk-up
 	if cmd.Flags().Changed("dir") || cmd.Flags().Changed("project") {
 		if projectDir != "" {
-			return filepath.Join(projectDir, issuesDir), false, nil
+			return filepath.Join(projectDir, issuesDir), false, "", nil
 		}
-		return issuesDir, false, nil
+		return issuesDir, false, "", nil
 	}
 
 	// Do walk-up discovery
 	cwd, err := os.Getwd()
 	if err != nil {
-		return "", false, err
+		return "", false, "", err
+	}
+
+	// Stop at the git root unless --no-boundary; only ask git when the
+	// search has to leave the current directory
+	boundary := ""
+	noBoundary, _ := cmd.Flags().GetBool("no-boundary")
+	if stat, err := os.Stat(filepath.Join(cwd, ".issues")); !noBoundary && (err != nil || !stat.IsDir()) {
+		boundary = issue.GitRoot(cwd)
 	}
 
-	path, discovered := findIssuesDir(cwd)
-	return path, discovered, nil
+	path, discovered = findIssuesDir(cwd, boundary)
+	if !discovered && boundary != "" && filepath.Dir(boundary) != boundary {
+		if stat, err := os.Stat(path); err != nil || !stat.IsDir() {
+			stoppedAt = boundary
+		}
+	}
+	return path, discovered, stoppedAt, nil
 }
 
 // getIssuesDir returns the issues directory path, combining -C and -d flags
@@ -179,6 +245,99 @@ func getMultiStore(cmd *cobra.Command) (*project.MultiStore, error) {
 	return project.NewMultiStore(specs, issuesDir)
 }
 
+// applyWorkflow loads the project config (.zap.yml) for the store's issues
+// directory and enforces its workflow policy on the store
+func applyWorkflow(store *issue.Store) error {
+	cfg, err := config.Load(store.BaseDir())
+	if err != nil {
+		return err
+	}
+	store.SetWorkflow(cfg.WorkflowPolicy())
+	return nil
+}
+
+// loadLabelColors loads the label color mapping from .zap.yml for each
+// issues directory. Earlier directories win when a label is configured twice.
+// Config errors are ignored here; colors are cosmetic. Invalid colors are
+// skipped with a warning.
+func loadLabelColors(issuesDirs ...string) {
+	labelColors = make(map[string]string)
+	for _, dir := range issuesDirs {
+		cfg, err := config.Load(dir)
+		if err != nil {
+			continue
+		}
+		colors, warnings := cfg.ValidLabelColors()
+		for _, w := range warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s: %s\n", config.Path(dir), w)
+		}
+		for label, color := range colors {
+			if _, ok := labelColors[label]; !ok {
+				labelColors[label] = color
+			}
+		}
+	}
+}
+
+// loadLanguage selects the output language from ZAP_LANG or .zap.yml.
+// An invalid config leaves the default language in place.
+func loadLanguage(issuesDir string) {
+	configured := ""
+	if cfg, err := config.Load(issuesDir); err == nil {
+		configured = cfg.Lang
+	}
+	i18n.Set(i18n.Resolve(configured))
+}
+
+// loadStates applies the states section of .zap.yml before a command runs.
+// In multi-project mode the first project that defines states wins. An
+// invalid config leaves the built-in states in place; commands that load
+// the config report the error.
+func loadStates(cmd *cobra.Command) {
+	var dirs []string
+	if specs := getProjectSpecs(cmd); len(specs) > 1 {
+		issuesDir, _ := cmd.Flags().GetString("dir")
+		for _, spec := range specs {
+			dirs = append(dirs, filepath.Join(spec.Path, issuesDir))
+		}
+	} else if dir, _, _, err := discoverIssuesDir(cmd); err == nil {
+		dirs = append(dirs, dir)
+	}
+
+	issue.SetStates(nil)
+	for _, dir := range dirs {
+		if cfg, err := config.Load(dir); err == nil && len(cfg.States) > 0 {
+			issue.SetStates(cfg.States)
+			return
+		}
+	}
+}
+
+// setStateHelp rebuilds the help texts that list the issue states from the
+// states in use, so --help shows the states section of .zap.yml.
+func setStateHelp() {
+	valid := issue.ValidStates()
+	listCmd.Flags().Lookup("state").Usage = "Filter by state (" + valid + ")"
+	watchCmd.Flags().Lookup("state").Usage = "Filter by state (" + valid + ")"
+	newCmd.Flags().Lookup("state").Usage = "Initial state (" + valid + ")"
+	setCmd.Short = "Set issue state (" + valid + ")"
+	setCmd.Long = fmt.Sprintf(setLongFormat, valid)
+	validateCmd.Long = fmt.Sprintf(validateLongFormat, valid)
+	fixStateCmd.Long = fmt.Sprintf(fixStateLongFormat, valid)
+}
+
+// commitRefs finds issue references in commit messages (see loadRefMatcher)
+var commitRefs = issue.DefaultRefMatcher()
+
+// loadRefMatcher configures commit reference matching from .zap.yml.
+// An invalid config leaves the default (#N) in place.
+func loadRefMatcher(issuesDir string) {
+	commitRefs = issue.DefaultRefMatcher()
+	if cfg, err := config.Load(issuesDir); err == nil {
+		commitRefs = cfg.RefMatcher()
+	}
+}
+
 // getStore returns an issue.Store for single-project mode
 // This is the existing behavior for backward compatibility
 func getStore(cmd *cobra.Command) (*issue.Store, error) {
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+func TestFindIssuesDir(t *testing.T) {
+	base := t.TempDir()
+	outer := filepath.Join(base, ".issues")
+	repo := filepath.Join(base, "repo")
+	sub := filepath.Join(repo, "pkg", "sub")
+	for _, dir := range []string{outer, sub} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		start          string
+		boundary       string
+		wantPath       string
+		wantDiscovered bool
+	}{
+		{"unbounded finds parent", sub, "", outer, true},
+		{"stops at boundary", sub, repo, filepath.Join(sub, ".issues"), false},
+		{"boundary above the match", sub, base, outer, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, discovered := findIssuesDir(tt.start, tt.boundary)
+			if path != tt.wantPath || discovered != tt.wantDiscovered {
+				t.Errorf("findIssuesDir() = %q, %v, want %q, %v", path, discovered, tt.wantPath, tt.wantDiscovered)
+			}
+		})
+	}
+
+	// Inside the boundary, a repo-level .issues is still found
+	inner := filepath.Join(repo, ".issues")
+	if err := os.Mkdir(inner, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if path, discovered := findIssuesDir(sub, repo); path != inner || !discovered {
+		t.Errorf("findIssuesDir() = %q, %v, want %q, true", path, discovered, inner)
+	}
+}
+
+func TestSetStateHelp(t *testing.T) {
+	t.Cleanup(func() {
+		issue.SetStates(nil)
+		setStateHelp()
+	})
+	issue.SetStates(issue.StateSet{
+		{Name: issue.StateOpen, Active: true},
+		{Name: issue.StateWip, Active: true},
+		{Name: "review", Active: true},
+		{Name: issue.StateDone, Terminal: true},
+		{Name: issue.StateClosed, Terminal: true},
+	})
+	setStateHelp()
+
+	const want = "open, wip, review, done, closed"
+	for name, text := range map[string]string{
+		"list --state":  listCmd.Flags().Lookup("state").Usage,
+		"watch --state": watchCmd.Flags().Lookup("state").Usage,
+		"new --state":   newCmd.Flags().Lookup("state").Usage,
+		"set short":     setCmd.Short,
+		"set long":      setCmd.Long,
+		"validate long": validateCmd.Long,
+		"fix-state":     fixStateCmd.Long,
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("%s does not list %q:\n%s", name, want, text)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxSelectorRange caps how many numbers a single range may expand to.
+const maxSelectorRange = 10000
+
+// parseNumberSelectors parses issue number arguments such as "3", "10-20"
+// and "25" into a sorted list of unique numbers.
+func parseNumberSelectors(args []string) ([]int, error) {
+	seen := make(map[int]bool)
+	for _, arg := range args {
+		lo, hi, isRange := strings.Cut(arg, "-")
+		if !isRange {
+			n, err := parseSelectorNumber(arg)
+			if err != nil {
+				return nil, err
+			}
+			seen[n] = true
+			continue
+		}
+
+		start, err := parseSelectorNumber(lo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q: %w", arg, err)
+		}
+		end, err := parseSelectorNumber(hi)
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q: %w", arg, err)
+		}
+		if start > end {
+			return nil, fmt.Errorf("invalid range %q: start is greater than end", arg)
+		}
+		if end-start >= maxSelectorRange {
+			return nil, fmt.Errorf("invalid range %q: more than %d issues", arg, maxSelectorRange)
+		}
+		for n := start; n <= end; n++ {
+			seen[n] = true
+		}
+	}
+
+	numbers := make([]int, 0, len(seen))
+	for n := range seen {
+		numbers = append(numbers, n)
+	}
+	sort.Ints(numbers)
+	return numbers, nil
+}
+
+// printSkippedSelectors prints one line for the selected numbers that were
+// skipped, rather than one per number, since a range may skip many.
+func printSkippedSelectors(skipped []int, reason string) {
+	switch len(skipped) {
+	case 0:
+	case 1:
+		fmt.Printf("⚠️  Skipping #%d: %s\n", skipped[0], reason)
+	default:
+		fmt.Printf("⚠️  Skipping %d selected issues: %s\n", len(skipped), reason)
+	}
+}
+
+// parseSelectorNumber parses a positive issue number, allowing a leading #.
+func parseSelectorNumber(s string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(s), "#"))
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid issue number: %s", s)
+	}
+	return n, nil
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseNumberSelectors(t *testing.T) {
+	tests := []struct {
+		args    []string
+		want    []int
+		wantErr bool
+	}{
+		{[]string{"5"}, []int{5}, false},
+		{[]string{"1", "3", "5"}, []int{1, 3, 5}, false},
+		{[]string{"10-13", "25"}, []int{10, 11, 12, 13, 25}, false},
+		{[]string{"3-5", "4", "#1"}, []int{1, 3, 4, 5}, false},
+		{[]string{"7-7"}, []int{7}, false},
+		{[]string{"20-10"}, nil, true},
+		{[]string{"abc"}, nil, true},
+		{[]string{"0"}, nil, true},
+		{[]string{"1-"}, nil, true},
+		{[]string{"-3"}, nil, true},
+		{[]string{"1-2-3"}, nil, true},
+		{[]string{"1-100000"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseNumberSelectors(tt.args)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseNumberSelectors(%v) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !slices.Equal(got, tt.want) {
+			t.Errorf("parseNumberSelectors(%v) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
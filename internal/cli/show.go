@@ -1,52 +1,108 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"os/signal"
-	"runtime"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/charmbracelet/glamour"
-	"github.com/fsnotify/fsnotify"
 	"github.com/itda-work/zap/internal/issue"
-	"github.com/itda-work/zap/internal/project"
+	"github.com/itda-work/zap/internal/notify"
 	"github.com/spf13/cobra"
 )
 
 var showCmd = &cobra.Command{
-	Use:               "show <number>",
-	Aliases:           []string{"s"},
-	Short:             "Show issue details",
-	Long:              `Show detailed information about a specific issue.`,
-	Args:              cobra.ExactArgs(1),
+	Use:     "show [number]",
+	Aliases: []string{"s"},
+	Short:   "Show issue details",
+	Long: `Show detailed information about a specific issue.
+
+Without a number in a terminal, the issue is picked interactively.
+
+Examples:
+  zap show 1
+  zap show                   # Pick the issue interactively
+  zap show 1 --raw
+  zap show 1 --raw --json-frontmatter  # Frontmatter as JSON, body as raw markdown
+  zap show 1 --format json   # Structured output for editor integrations
+  zap show 1 --word-wrap 80  # Wrap the body at 80 columns (default: terminal width)
+  zap show 1 --history       # State timeline reconstructed from git
+  zap show 1 --refs --json   # Reference graph as nodes and edges
+  zap show 1 --refs --dot | dot -Tsvg > refs.svg  # Graphviz diagram`,
+	Args:              issueArgs(1),
 	ValidArgsFunction: completeIssueNumber,
 	RunE:              runShow,
 }
 
 var (
-	showRaw     bool
-	showRefs    bool
-	showWatch   bool
-	showNotify  bool
-	showProject string
+	showRaw      bool
+	showRawJSON  bool
+	showRefs     bool
+	showRefsJSON bool
+	showRefsDOT  bool
+	showDepth    int
+	showWatch    bool
+	showNotify   bool
+	showProject  string
+	showFormat   string
+	showFor      time.Duration
+	showWrap     int
+	showHistory  bool
 )
 
+// defaultWordWrap is the markdown wrap width when the output width is unknown.
+const defaultWordWrap = 100
+
 func init() {
 	rootCmd.AddCommand(showCmd)
 
 	showCmd.Flags().BoolVar(&showRaw, "raw", false, "Show raw markdown content")
+	showCmd.Flags().BoolVar(&showRawJSON, "json-frontmatter", false, "With --raw, print {frontmatter, body} as JSON")
 	showCmd.Flags().BoolVar(&showRefs, "refs", false, "Show referenced issues graph")
+	showCmd.Flags().IntVar(&showDepth, "depth", 0, "Limit --refs tree depth (0 = unlimited)")
+	showCmd.Flags().BoolVar(&showRefsJSON, "json", false, "With --refs, print only the reference graph as JSON")
+	showCmd.Flags().BoolVar(&showRefsDOT, "dot", false, "With --refs, print only the reference graph as Graphviz DOT")
 	showCmd.Flags().BoolVarP(&showWatch, "watch", "w", false, "Watch for file changes (like tail -f)")
 	showCmd.Flags().BoolVar(&showNotify, "notify", false, "Send system notification when state changes to done (requires -w)")
-	showCmd.Flags().StringVarP(&showProject, "project", "p", "", "Project alias (for multi-project mode)")
+	showCmd.Flags().DurationVar(&showFor, "for", 0, "Stop watching after this duration (requires -w)")
+	showCmd.Flags().StringVarP(&showProject, "alias", "p", "", "Project alias (for multi-project mode)")
+	showCmd.Flags().StringVarP(&showFormat, "format", "f", "text", "Output format (text, json)")
+	showCmd.Flags().IntVar(&showWrap, "word-wrap", 0, "Wrap the body at N columns (0 = terminal width)")
+	showCmd.Flags().BoolVar(&showHistory, "history", false, "Show the state timeline reconstructed from git log")
 }
 
 func runShow(cmd *cobra.Command, args []string) error {
+	if showFormat != "text" && showFormat != "json" {
+		return fmt.Errorf("invalid format: %s (valid: text, json)", showFormat)
+	}
+	if showRawJSON && !showRaw {
+		return fmt.Errorf("--json-frontmatter requires --raw")
+	}
+	if showWrap < 0 {
+		return fmt.Errorf("--word-wrap must not be negative")
+	}
+	if showRefsJSON || showRefsDOT {
+		switch {
+		case !showRefs:
+			return fmt.Errorf("--json and --dot require --refs")
+		case showRefsJSON && showRefsDOT:
+			return fmt.Errorf("--json and --dot cannot be used together")
+		case showWatch || showFormat == "json":
+			return fmt.Errorf("--json and --dot cannot be used with --watch or --format json")
+		}
+	}
+
+	args, err := pickIssueArg(cmd, args, 1)
+	if err != nil {
+		return err
+	}
+
 	// Check for multi-project mode
 	if isMultiProjectMode(cmd) {
 		return runMultiProjectShow(cmd, args)
@@ -83,50 +139,9 @@ func runMultiProjectShow(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Parse the issue argument - could be "project/#number" or just "number"
-	arg := args[0]
-
-	var pIss *project.ProjectIssue
-
-	// Check if it's a project reference (e.g., "zap/#1")
-	if project.IsProjectRef(arg) {
-		ref, err := project.ParseRef(arg)
-		if err != nil {
-			return err
-		}
-		pIss, err = multiStore.GetByRef(ref)
-		if err != nil {
-			return err
-		}
-	} else {
-		// It's just a number - need to find it
-		number, err := strconv.Atoi(arg)
-		if err != nil {
-			return fmt.Errorf("invalid issue reference: %s (expected: number or project/#number)", arg)
-		}
-
-		// If --project flag is specified, use it
-		if showProject != "" {
-			pIss, err = multiStore.Get(showProject, number)
-			if err != nil {
-				return err
-			}
-		} else {
-			// Search across all projects
-			matches := multiStore.FindByNumber(number)
-			if len(matches) == 0 {
-				return fmt.Errorf("issue #%d not found in any project", number)
-			}
-			if len(matches) > 1 {
-				// Ambiguous - show all matches
-				fmt.Fprintf(os.Stderr, "Issue #%d exists in multiple projects:\n", number)
-				for _, m := range matches {
-					fmt.Fprintf(os.Stderr, "  - %s (%s)\n", m.Ref(), m.Title)
-				}
-				return fmt.Errorf("please specify project with --project or use project/#number format")
-			}
-			pIss = matches[0]
-		}
+	pIss, err := multiStore.Resolve(args[0], showProject)
+	if err != nil {
+		return err
 	}
 
 	// Get the store for this project to use existing display functions
@@ -140,6 +155,18 @@ func runMultiProjectShow(cmd *cobra.Command, args []string) error {
 }
 
 func displayIssue(store *issue.Store, iss *issue.Issue) error {
+	if showRefsJSON || showRefsDOT {
+		return printRefsExport(store, iss)
+	}
+
+	if showFormat == "json" {
+		return printIssueJSON(store, iss)
+	}
+
+	if showRaw && showRawJSON {
+		return printRawIssueJSON(iss)
+	}
+
 	if showRaw {
 		printRawIssue(iss)
 	} else {
@@ -147,21 +174,24 @@ func displayIssue(store *issue.Store, iss *issue.Issue) error {
 	}
 
 	if showRefs {
-		printRefsGraph(store, iss.Number)
+		printRefsGraph(store, iss.Number, showDepth)
+	}
+
+	if showHistory {
+		fmt.Println()
+		printGitStateTimeline(iss)
 	}
 
 	return nil
 }
 
 func watchIssue(store *issue.Store, iss *issue.Issue) error {
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		return fmt.Errorf("failed to create file watcher: %w", err)
-	}
-	defer watcher.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	if err := watcher.Add(iss.FilePath); err != nil {
-		return fmt.Errorf("failed to watch file: %w", err)
+	events, err := watchStores(ctx, []*issue.Store{store})
+	if err != nil {
+		return err
 	}
 
 	sigChan := make(chan os.Signal, 1)
@@ -174,39 +204,42 @@ func watchIssue(store *issue.Store, iss *issue.Issue) error {
 	}
 	printWatchHint()
 
-	debounce := time.NewTimer(0)
-	debounce.Stop()
-	defer debounce.Stop()
-
 	prevState := iss.State
+	deadline := exitAfter(showFor)
 
 	for {
 		select {
-		case event, ok := <-watcher.Events:
+		case event, ok := <-events:
 			if !ok {
 				return nil
 			}
 
-			if event.Has(fsnotify.Write) || event.Has(fsnotify.Create) {
-				debounce.Reset(50 * time.Millisecond)
-			}
-
-			if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
-				time.Sleep(100 * time.Millisecond)
-				if _, err := os.Stat(iss.FilePath); err == nil {
-					watcher.Add(iss.FilePath)
-				} else {
+			switch event.Type {
+			case issue.EventError:
+				fmt.Fprintf(os.Stderr, "Watch error: %v\n", event.Err)
+				continue
+			case issue.EventRemoved:
+				if event.Path != iss.FilePath {
+					continue
+				}
+				// A rename (zap mv) removes the old path and creates a new one
+				moved, err := store.Get(iss.Number)
+				if err != nil {
 					fmt.Println("\nFile was removed. Stopping watch.")
 					return nil
 				}
-			}
-
-		case <-debounce.C:
-			updated, err := issue.Parse(iss.FilePath)
-			if err != nil {
+				iss.FilePath = moved.FilePath
+				continue
+			case issue.EventCreated, issue.EventUpdated:
+				if event.Issue == nil || event.Issue.Number != iss.Number {
+					continue
+				}
+				iss.FilePath = event.Path
+			default:
 				continue
 			}
 
+			updated := event.Issue
 			clearScreen()
 			if err := displayIssue(store, updated); err != nil {
 				fmt.Fprintf(os.Stderr, "Error displaying issue: %v\n", err)
@@ -219,15 +252,13 @@ func watchIssue(store *issue.Store, iss *issue.Issue) error {
 
 			printWatchHint()
 
-		case err, ok := <-watcher.Errors:
-			if !ok {
-				return nil
-			}
-			fmt.Fprintf(os.Stderr, "Watch error: %v\n", err)
-
 		case <-sigChan:
 			fmt.Println("\nStopping watch...")
 			return nil
+
+		case <-deadline:
+			fmt.Println("\nStopping watch...")
+			return nil
 		}
 	}
 }
@@ -242,37 +273,49 @@ func printWatchHint() {
 }
 
 func notifyDone(iss *issue.Issue) {
-	// Terminal bell
-	fmt.Print("\a")
-
 	// Visual notification
 	fmt.Println()
-	fmt.Println(colorize("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━", colorGreen))
-	fmt.Println(colorize(fmt.Sprintf("✓ Issue #%d marked as done!", iss.Number), colorGreen))
-	fmt.Println(colorize("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━", colorGreen))
+	fmt.Println(colorize(hrule("━"), colorGreen))
+	fmt.Println(colorize(fmt.Sprintf("%s Issue #%d marked as done!", glyph("✓", "*"), iss.Number), colorGreen))
+	fmt.Println(colorize(hrule("━"), colorGreen))
 
-	// System notification (if --notify flag is set)
+	// System notification (if --notify flag is set), otherwise terminal bell.
+	// notify.Send rings the bell itself when no backend is available.
 	if showNotify {
-		sendSystemNotification(
-			"Issue Completed",
-			fmt.Sprintf("#%d: %s", iss.Number, iss.Title),
-		)
+		_ = notify.Send("Issue Completed", fmt.Sprintf("#%d: %s", iss.Number, iss.Title))
+	} else {
+		fmt.Print("\a")
 	}
 }
 
-func sendSystemNotification(title, message string) {
-	if runtime.GOOS != "darwin" {
-		return
+// printIssueLinks prints one line per non-empty relationship list.
+func printIssueLinks(iss *issue.Issue) {
+	links := []struct {
+		label   string
+		numbers []int
+	}{
+		{"Relates: ", iss.RelatesTo},
+		{"Dup of:  ", iss.Duplicates},
+		{"Dup by:  ", iss.DuplicatedBy},
+		{"Blocks:  ", iss.Blocks},
+		{"Blocked: ", iss.BlockedBy},
+	}
+	for _, l := range links {
+		if len(l.numbers) == 0 {
+			continue
+		}
+		refs := make([]string, len(l.numbers))
+		for i, n := range l.numbers {
+			refs[i] = fmt.Sprintf("#%d", n)
+		}
+		fmt.Printf("%s %s\n", l.label, strings.Join(refs, ", "))
 	}
-
-	script := fmt.Sprintf(`display notification "%s" with title "%s"`, message, title)
-	exec.Command("osascript", "-e", script).Run()
 }
 
 func printIssueDetail(iss *issue.Issue) {
-	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Println(hrule("━"))
 	fmt.Printf("Issue #%d: %s\n", iss.Number, iss.Title)
-	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Println(hrule("━"))
 	fmt.Printf("State:    %s\n", iss.State)
 
 	if len(iss.Labels) > 0 {
@@ -283,6 +326,13 @@ func printIssueDetail(iss *issue.Issue) {
 		fmt.Printf("Assignee: %s\n", strings.Join(iss.Assignees, ", "))
 	}
 
+	if iss.Points > 0 {
+		fmt.Printf("Points:   %d\n", iss.Points)
+	}
+
+	printIssueLinks(iss)
+	printIssueAttachments(iss)
+
 	fmt.Printf("Created:  %s\n", iss.CreatedAt.Local().Format("2006-01-02 15:04"))
 	fmt.Printf("Updated:  %s\n", iss.UpdatedAt.Local().Format("2006-01-02 15:04"))
 
@@ -291,10 +341,20 @@ func printIssueDetail(iss *issue.Issue) {
 	}
 
 	fmt.Printf("File:     %s\n", iss.FilePath)
-	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 
-	if iss.Body != "" {
-		rendered, err := renderMarkdown(iss.Body)
+	if len(iss.StateHistory) > 0 {
+		fmt.Printf("History:\n")
+		for _, entry := range iss.StateHistory {
+			fmt.Printf("  %s  %s %s\n", entry.At.Local().Format("2006-01-02 15:04"), glyph("→", "->"), entry.State)
+		}
+	}
+
+	fmt.Println(hrule("━"))
+
+	if iss.Body != "" && plainOutput {
+		fmt.Printf("\n%s\n", iss.Body)
+	} else if iss.Body != "" {
+		rendered, err := renderMarkdown(iss.Body, wordWrapWidth())
 		if err != nil {
 			fmt.Printf("\n%s\n", iss.Body)
 		} else {
@@ -303,10 +363,22 @@ func printIssueDetail(iss *issue.Issue) {
 	}
 }
 
-func renderMarkdown(content string) (string, error) {
+// wordWrapWidth returns the width issue bodies are wrapped at: --word-wrap,
+// then the output width, then defaultWordWrap.
+func wordWrapWidth() int {
+	if showWrap > 0 {
+		return showWrap
+	}
+	if width, ok := detectOutputWidth(); ok {
+		return width
+	}
+	return defaultWordWrap
+}
+
+func renderMarkdown(content string, wrap int) (string, error) {
 	renderer, err := glamour.NewTermRenderer(
 		glamour.WithAutoStyle(),
-		glamour.WithWordWrap(100),
+		glamour.WithWordWrap(wrap),
 		glamour.WithStylesFromJSONBytes([]byte(compactStyle)),
 	)
 	if err != nil {
@@ -435,7 +507,242 @@ func printRawIssue(iss *issue.Issue) {
 	fmt.Print(string(data))
 }
 
-func printRefsGraph(store *issue.Store, issueNum int) {
+// RawIssueJSON is the JSON structure for --raw --json-frontmatter.
+type RawIssueJSON struct {
+	Frontmatter FrontmatterJSON `json:"frontmatter"`
+	Body        string          `json:"body"`
+}
+
+// printIssueAttachments lists the issue's attachments, linking each title to
+// its URL.
+func printIssueAttachments(iss *issue.Issue) {
+	if len(iss.Attachments) == 0 {
+		return
+	}
+	fmt.Printf("Attachments:\n")
+	for _, a := range iss.Attachments {
+		if a.Label() == a.URL {
+			fmt.Printf("  %s\n", hyperlink(a.URL, a.URL))
+			continue
+		}
+		fmt.Printf("  %s %s\n", hyperlink(a.Label(), a.URL), colorize("("+a.URL+")", colorGray))
+	}
+}
+
+// FrontmatterJSON mirrors the issue frontmatter as written by issue.Serialize.
+// Unknown keys kept on the issue are included under extra.
+type FrontmatterJSON struct {
+	Number       int               `json:"number"`
+	Title        string            `json:"title"`
+	State        string            `json:"state"`
+	Labels       []string          `json:"labels"`
+	Assignees    []string          `json:"assignees"`
+	Points       int               `json:"points,omitempty"`
+	CreatedAt    string            `json:"created_at"`
+	UpdatedAt    string            `json:"updated_at"`
+	ClosedAt     string            `json:"closed_at,omitempty"`
+	RelatesTo    []int             `json:"relates_to,omitempty"`
+	Duplicates   []int             `json:"duplicates,omitempty"`
+	DuplicatedBy []int             `json:"duplicated_by,omitempty"`
+	Blocks       []int             `json:"blocks,omitempty"`
+	BlockedBy    []int             `json:"blocked_by,omitempty"`
+	Attachments  []AttachmentJSON  `json:"attachments,omitempty"`
+	StateHistory []StateChangeJSON `json:"state_history,omitempty"`
+	Extra        map[string]any    `json:"extra,omitempty"`
+}
+
+// AttachmentJSON is an attachments entry.
+type AttachmentJSON struct {
+	Title string `json:"title,omitempty"`
+	URL   string `json:"url"`
+}
+
+// attachmentsJSON converts attachments for JSON output.
+func attachmentsJSON(attachments []issue.Attachment) []AttachmentJSON {
+	var out []AttachmentJSON
+	for _, a := range attachments {
+		out = append(out, AttachmentJSON{Title: a.Title, URL: a.URL})
+	}
+	return out
+}
+
+// StateChangeJSON is a state_history entry.
+type StateChangeJSON struct {
+	State string `json:"state"`
+	At    string `json:"at"`
+}
+
+// buildRawIssueJSON splits an issue into its frontmatter and raw body.
+func buildRawIssueJSON(iss *issue.Issue) RawIssueJSON {
+	fm := FrontmatterJSON{
+		Number:    iss.Number,
+		Title:     iss.Title,
+		State:     string(iss.State),
+		Labels:    iss.Labels,
+		Assignees: iss.Assignees,
+		Points:    iss.Points,
+		CreatedAt: iss.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt: iss.UpdatedAt.UTC().Format(time.RFC3339),
+		Extra:     iss.Extra,
+
+		RelatesTo:    iss.RelatesTo,
+		Duplicates:   iss.Duplicates,
+		DuplicatedBy: iss.DuplicatedBy,
+		Blocks:       iss.Blocks,
+		BlockedBy:    iss.BlockedBy,
+		Attachments:  attachmentsJSON(iss.Attachments),
+	}
+
+	if fm.Labels == nil {
+		fm.Labels = []string{}
+	}
+	if fm.Assignees == nil {
+		fm.Assignees = []string{}
+	}
+	if iss.ClosedAt != nil {
+		fm.ClosedAt = iss.ClosedAt.UTC().Format(time.RFC3339)
+	}
+	for _, entry := range iss.StateHistory {
+		fm.StateHistory = append(fm.StateHistory, StateChangeJSON{
+			State: string(entry.State),
+			At:    entry.At.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return RawIssueJSON{Frontmatter: fm, Body: iss.Body}
+}
+
+// printRawIssueJSON writes an issue's frontmatter and raw body as JSON.
+func printRawIssueJSON(iss *issue.Issue) error {
+	out, err := json.MarshalIndent(buildRawIssueJSON(iss), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to format JSON: %w", err)
+	}
+
+	fmt.Println(string(out))
+	return nil
+}
+
+// IssueDetailJSON is the JSON structure for a single issue.
+type IssueDetailJSON struct {
+	Number    int          `json:"number"`
+	Title     string       `json:"title"`
+	State     string       `json:"state"`
+	Labels    []string     `json:"labels"`
+	Assignees []string     `json:"assignees"`
+	Points    int          `json:"points,omitempty"`
+	CreatedAt string       `json:"created_at"`
+	UpdatedAt string       `json:"updated_at"`
+	ClosedAt  string       `json:"closed_at,omitempty"`
+	FilePath  string       `json:"file_path"`
+	Body      string       `json:"body"`
+	Refs      RefCountJSON `json:"refs"`
+	Links     *LinksJSON   `json:"links,omitempty"`
+
+	Attachments []AttachmentJSON `json:"attachments,omitempty"`
+
+	GitHistory []GitStateChangeJSON `json:"git_history,omitempty"`
+}
+
+// GitStateChangeJSON is a state change recovered from git (--history).
+type GitStateChangeJSON struct {
+	State  string `json:"state"`
+	At     string `json:"at"`
+	Commit string `json:"commit"`
+}
+
+// LinksJSON is the JSON structure for an issue's recorded relationships.
+type LinksJSON struct {
+	RelatesTo    []int `json:"relates_to,omitempty"`
+	Duplicates   []int `json:"duplicates,omitempty"`
+	DuplicatedBy []int `json:"duplicated_by,omitempty"`
+	Blocks       []int `json:"blocks,omitempty"`
+	BlockedBy    []int `json:"blocked_by,omitempty"`
+}
+
+// RefCountJSON is the JSON structure for an issue's reference counts.
+type RefCountJSON struct {
+	Mentions    int `json:"mentions"`
+	MentionedBy int `json:"mentioned_by"`
+}
+
+// buildIssueJSON converts an issue to its JSON representation.
+// graph may be nil, in which case reference counts are zero.
+func buildIssueJSON(iss *issue.Issue, graph *issue.RefGraph) IssueDetailJSON {
+	detail := IssueDetailJSON{
+		Number:    iss.Number,
+		Title:     iss.Title,
+		State:     string(iss.State),
+		Labels:    iss.Labels,
+		Assignees: iss.Assignees,
+		Points:    iss.Points,
+		CreatedAt: iss.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt: iss.UpdatedAt.UTC().Format(time.RFC3339),
+		FilePath:  iss.FilePath,
+		Body:      iss.Body,
+
+		Attachments: attachmentsJSON(iss.Attachments),
+	}
+
+	if detail.Labels == nil {
+		detail.Labels = []string{}
+	}
+	if detail.Assignees == nil {
+		detail.Assignees = []string{}
+	}
+	if iss.ClosedAt != nil {
+		detail.ClosedAt = iss.ClosedAt.UTC().Format(time.RFC3339)
+	}
+	if graph != nil {
+		detail.Refs.Mentions = len(graph.Mentions[iss.Number])
+		detail.Refs.MentionedBy = len(graph.MentionedBy[iss.Number])
+	}
+	links := LinksJSON{
+		RelatesTo:    iss.RelatesTo,
+		Duplicates:   iss.Duplicates,
+		DuplicatedBy: iss.DuplicatedBy,
+		Blocks:       iss.Blocks,
+		BlockedBy:    iss.BlockedBy,
+	}
+	if len(links.RelatesTo)+len(links.Duplicates)+len(links.DuplicatedBy)+len(links.Blocks)+len(links.BlockedBy) > 0 {
+		detail.Links = &links
+	}
+
+	return detail
+}
+
+// printIssueJSON writes a single issue as indented JSON to stdout.
+func printIssueJSON(store *issue.Store, iss *issue.Issue) error {
+	graph, err := store.BuildRefGraph()
+	if err != nil {
+		return fmt.Errorf("failed to build reference graph: %w", err)
+	}
+
+	detail := buildIssueJSON(iss, graph)
+	if showHistory {
+		// Files not committed to git have no history
+		timeline, _ := gitStateTimeline(iss.FilePath)
+		for _, t := range timeline {
+			detail.GitHistory = append(detail.GitHistory, GitStateChangeJSON{
+				State:  string(t.State),
+				At:     t.At.UTC().Format(time.RFC3339),
+				Commit: t.Commit,
+			})
+		}
+	}
+
+	out, err := json.MarshalIndent(detail, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to format JSON: %w", err)
+	}
+
+	fmt.Println(string(out))
+	return nil
+}
+
+// printRefsGraph prints the reference tree of an issue.
+// depth limits how many levels are shown (0 = unlimited).
+func printRefsGraph(store *issue.Store, issueNum int, depth int) {
 	graph, err := store.BuildRefGraph()
 	if err != nil {
 		fmt.Printf("Error building reference graph: %v\n", err)
@@ -449,15 +756,34 @@ func printRefsGraph(store *issue.Store, issueNum int) {
 
 	fmt.Println()
 	fmt.Println()
-	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Println(hrule("━"))
 	fmt.Println("Referenced Issues:")
-	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
-	printRefTree(tree, "", true)
+	fmt.Println(hrule("━"))
+	printRefTree(tree, "", true, depth)
 	fmt.Println()
-	fmt.Println(colorize("(→: mentions, ←: mentioned by)", colorGray))
+	fmt.Println(colorize(fmt.Sprintf("(%s: mentions, %s: mentioned by)", glyph("→", "->"), glyph("←", "<-")), colorGray))
 }
 
-func printRefTree(nodes []*issue.TreeNode, prefix string, isRoot bool) {
+// printRefsExport prints the reference graph of an issue for --json or --dot.
+func printRefsExport(store *issue.Store, iss *issue.Issue) error {
+	graph, err := store.BuildRefGraph()
+	if err != nil {
+		return fmt.Errorf("failed to build reference graph: %w", err)
+	}
+
+	refs := buildRefGraphJSON(graph, iss, showDepth)
+	if showRefsDOT {
+		return writeRefGraphDOT(os.Stdout, refs)
+	}
+	out, err := json.MarshalIndent(refs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to format JSON: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func printRefTree(nodes []*issue.TreeNode, prefix string, isRoot bool, depth int) {
 	for i, node := range nodes {
 		isLast := i == len(nodes)-1
 
@@ -465,22 +791,22 @@ func printRefTree(nodes []*issue.TreeNode, prefix string, isRoot bool) {
 		var connector string
 		if isRoot {
 			if isLast {
-				connector = "└── "
+				connector = glyph("└── ", "`-- ")
 			} else {
-				connector = "├── "
+				connector = glyph("├── ", "|-- ")
 			}
 		} else {
 			if isLast {
-				connector = "└── "
+				connector = glyph("└── ", "`-- ")
 			} else {
-				connector = "├── "
+				connector = glyph("├── ", "|-- ")
 			}
 		}
 
 		// Direction arrow
-		arrow := "→"
+		arrow := glyph("→", "->")
 		if node.Direction == issue.RefMentionedBy {
-			arrow = "←"
+			arrow = glyph("←", "<-")
 		}
 
 		// State tag and color
@@ -497,24 +823,40 @@ func printRefTree(nodes []*issue.TreeNode, prefix string, isRoot bool) {
 			if isLast {
 				childPrefix = prefix + "    "
 			} else {
-				childPrefix = prefix + "│   "
+				childPrefix = prefix + glyph("│   ", "|   ")
 			}
 		} else {
 			if isLast {
 				childPrefix = prefix + "    "
 			} else {
-				childPrefix = prefix + "│   "
+				childPrefix = prefix + glyph("│   ", "|   ")
 			}
 		}
 
-		// Print children
+		// Print children, or a summary once the depth limit is reached
 		if len(node.Children) > 0 {
-			printRefTree(node.Children, childPrefix, false)
+			if depth == 1 {
+				fmt.Printf("%s%s\n", childPrefix, colorize(fmt.Sprintf("%s (%d more)", glyph("…", "..."), countRefNodes(node.Children)), colorGray))
+			} else {
+				printRefTree(node.Children, childPrefix, false, depth-1)
+			}
 		}
 	}
 }
 
+// countRefNodes returns the number of nodes in a reference subtree.
+func countRefNodes(nodes []*issue.TreeNode) int {
+	n := len(nodes)
+	for _, node := range nodes {
+		n += countRefNodes(node.Children)
+	}
+	return n
+}
+
 func stateColor(s issue.State) string {
+	if def, ok := issue.LookupState(s); ok && def.Color != "" {
+		return namedColor(def.Color)
+	}
 	switch s {
 	case issue.StateWip:
 		return colorYellow
@@ -1,9 +1,14 @@
 package cli
 
 import (
+	"encoding/json"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
 )
 
 func TestRenderMarkdownNoConsecutiveNewlines(t *testing.T) {
@@ -13,7 +18,7 @@ func TestRenderMarkdownNoConsecutiveNewlines(t *testing.T) {
 		t.Fatalf("Failed to read test file: %v", err)
 	}
 
-	rendered, err := renderMarkdown(string(content))
+	rendered, err := renderMarkdown(string(content), defaultWordWrap)
 	if err != nil {
 		t.Fatalf("renderMarkdown failed: %v", err)
 	}
@@ -36,7 +41,7 @@ func TestRenderMarkdownHeadings(t *testing.T) {
 ## H2
 ### H3`
 
-	rendered, err := renderMarkdown(content)
+	rendered, err := renderMarkdown(content, defaultWordWrap)
 	if err != nil {
 		t.Fatalf("renderMarkdown failed: %v", err)
 	}
@@ -51,7 +56,7 @@ func TestRenderMarkdownTaskList(t *testing.T) {
 - [ ] Task 2
 - [x] Task 3`
 
-	rendered, err := renderMarkdown(content)
+	rendered, err := renderMarkdown(content, defaultWordWrap)
 	if err != nil {
 		t.Fatalf("renderMarkdown failed: %v", err)
 	}
@@ -66,7 +71,7 @@ func TestRenderMarkdownList(t *testing.T) {
 - Item 2
 - Item 3`
 
-	rendered, err := renderMarkdown(content)
+	rendered, err := renderMarkdown(content, defaultWordWrap)
 	if err != nil {
 		t.Fatalf("renderMarkdown failed: %v", err)
 	}
@@ -79,7 +84,7 @@ func TestRenderMarkdownList(t *testing.T) {
 func TestRenderMarkdownCodeBlock(t *testing.T) {
 	content := "```go\nfunc main() {}\n```"
 
-	rendered, err := renderMarkdown(content)
+	rendered, err := renderMarkdown(content, defaultWordWrap)
 	if err != nil {
 		t.Fatalf("renderMarkdown failed: %v", err)
 	}
@@ -94,7 +99,7 @@ func TestRenderMarkdownTable(t *testing.T) {
 |---|---|
 | 1 | 2 |`
 
-	rendered, err := renderMarkdown(content)
+	rendered, err := renderMarkdown(content, defaultWordWrap)
 	if err != nil {
 		t.Fatalf("renderMarkdown failed: %v", err)
 	}
@@ -108,7 +113,7 @@ func TestRenderMarkdownBlockQuote(t *testing.T) {
 	content := `> Quote line 1
 > Quote line 2`
 
-	rendered, err := renderMarkdown(content)
+	rendered, err := renderMarkdown(content, defaultWordWrap)
 	if err != nil {
 		t.Fatalf("renderMarkdown failed: %v", err)
 	}
@@ -125,7 +130,7 @@ func TestRenderMarkdownHorizontalRule(t *testing.T) {
 
 After`
 
-	rendered, err := renderMarkdown(content)
+	rendered, err := renderMarkdown(content, defaultWordWrap)
 	if err != nil {
 		t.Fatalf("renderMarkdown failed: %v", err)
 	}
@@ -143,7 +148,7 @@ Paragraph text here.
 ## Section 2
 More text.`
 
-	rendered, err := renderMarkdown(content)
+	rendered, err := renderMarkdown(content, defaultWordWrap)
 	if err != nil {
 		t.Fatalf("renderMarkdown failed: %v", err)
 	}
@@ -152,3 +157,117 @@ More text.`
 		t.Errorf("Mixed content contains consecutive newlines:\n%s", rendered)
 	}
 }
+
+// ansiEscape matches the color sequences glamour emits
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+func TestRenderMarkdownWordWrap(t *testing.T) {
+	content := strings.Repeat("word ", 40)
+
+	for _, wrap := range []int{40, 120} {
+		rendered, err := renderMarkdown(content, wrap)
+		if err != nil {
+			t.Fatalf("renderMarkdown failed: %v", err)
+		}
+		for _, line := range strings.Split(ansiEscape.ReplaceAllString(rendered, ""), "\n") {
+			if w := len(strings.TrimRight(line, " ")); w > wrap {
+				t.Errorf("wrap %d: line is %d columns: %q", wrap, w, line)
+			}
+		}
+	}
+}
+
+func TestWordWrapWidth(t *testing.T) {
+	origWrap, origWidth := showWrap, outputWidth
+	defer func() { showWrap, outputWidth = origWrap, origWidth }()
+
+	// Tests run without a terminal on stdout
+	showWrap, outputWidth = 0, 0
+	t.Setenv(EnvWidth, "")
+	if got := wordWrapWidth(); got != defaultWordWrap {
+		t.Errorf("unknown width: got %d, want %d", got, defaultWordWrap)
+	}
+
+	t.Setenv(EnvWidth, "72")
+	if got := wordWrapWidth(); got != 72 {
+		t.Errorf("%s=72: got %d", EnvWidth, got)
+	}
+
+	showWrap = 60
+	if got := wordWrapWidth(); got != 60 {
+		t.Errorf("--word-wrap 60: got %d", got)
+	}
+}
+
+func TestBuildIssueJSON(t *testing.T) {
+	created := time.Date(2026, 1, 17, 6, 30, 0, 0, time.UTC)
+	iss := &issue.Issue{
+		Number:    2,
+		Title:     "Test",
+		State:     issue.StateOpen,
+		CreatedAt: created,
+		UpdatedAt: created,
+		Body:      "See #1",
+	}
+
+	graph := issue.NewRefGraph()
+	graph.Mentions[2] = []int{1}
+	graph.MentionedBy[2] = []int{3, 4}
+
+	out, err := json.Marshal(buildIssueJSON(iss, graph))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`"labels":[]`,
+		`"assignees":[]`,
+		`"created_at":"2026-01-17T06:30:00Z"`,
+		`"body":"See #1"`,
+		`"refs":{"mentions":1,"mentioned_by":2}`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("JSON output missing %s: %s", want, out)
+		}
+	}
+	if strings.Contains(string(out), "closed_at") {
+		t.Errorf("closed_at should be omitted for open issue: %s", out)
+	}
+}
+
+func TestBuildRawIssueJSON(t *testing.T) {
+	created := time.Date(2026, 1, 17, 6, 30, 0, 0, time.UTC)
+	closed := created.Add(time.Hour)
+	iss := &issue.Issue{
+		Number:       3,
+		Title:        "Raw",
+		State:        issue.StateDone,
+		Labels:       []string{"bug"},
+		CreatedAt:    created,
+		UpdatedAt:    closed,
+		ClosedAt:     &closed,
+		StateHistory: []issue.StateChange{{State: issue.StateDone, At: closed}},
+		Extra:        map[string]any{"epic": "auth"},
+		Body:         "## Notes\n\n- [ ] item",
+	}
+
+	out, err := json.Marshal(buildRawIssueJSON(iss))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`"frontmatter":{"number":3,"title":"Raw","state":"done","labels":["bug"],"assignees":[]`,
+		`"closed_at":"2026-01-17T07:30:00Z"`,
+		`"state_history":[{"state":"done","at":"2026-01-17T07:30:00Z"}]`,
+		`"extra":{"epic":"auth"}`,
+		`"body":"## Notes\n\n- [ ] item"`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("JSON output missing %s: %s", want, out)
+		}
+	}
+	if strings.Contains(string(out), "refs") || strings.Contains(string(out), "file_path") {
+		t.Errorf("raw JSON should only hold frontmatter and body: %s", out)
+	}
+}
@@ -3,8 +3,11 @@ package cli
 import (
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/itda-work/zap/internal/issue"
+	"github.com/itda-work/zap/internal/project"
 	"github.com/spf13/cobra"
 )
 
@@ -16,7 +19,15 @@ var statsCmd = &cobra.Command{
 	RunE:    runStats,
 }
 
-var statsDateFilter DateFilter
+var (
+	statsDateFilter DateFilter
+	statsFailures   bool
+	statsActivity   bool
+	statsBy         string
+)
+
+// activityDays is the number of days shown by stats --activity
+const activityDays = 14
 
 func init() {
 	rootCmd.AddCommand(statsCmd)
@@ -30,9 +41,21 @@ func init() {
 	statsCmd.Flags().StringVar(&statsDateFilter.Date, "date", "", "Show statistics for specific date (YYYY-MM-DD)")
 	statsCmd.Flags().IntVar(&statsDateFilter.Days, "days", 0, "Show statistics for last N days")
 	statsCmd.Flags().IntVar(&statsDateFilter.Weeks, "weeks", 0, "Show statistics for last N weeks")
+
+	statsCmd.Flags().BoolVar(&statsFailures, "failures", false, "List files that failed to parse")
+	statsCmd.Flags().BoolVar(&statsActivity, "activity", false, fmt.Sprintf("Show issues created/closed per day over the last %d days", activityDays))
+	statsCmd.Flags().StringVar(&statsBy, "by", "", "Show closed/created issues per period (week, month)")
 }
 
 func runStats(cmd *cobra.Command, args []string) error {
+	if statsBy != "" && statsBy != "week" && statsBy != "month" {
+		return fmt.Errorf("invalid --by: %s (valid: week, month)", statsBy)
+	}
+
+	if isMultiProjectMode(cmd) {
+		return runMultiProjectStats(cmd)
+	}
+
 	dir, err := getIssuesDir(cmd)
 	if err != nil {
 		return err
@@ -45,6 +68,8 @@ func runStats(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to list issues: %w", err)
 	}
 
+	allIssues := issues
+
 	// Apply date filter if specified
 	filterDescription := ""
 	if !statsDateFilter.IsEmpty() {
@@ -58,21 +83,92 @@ func runStats(cmd *cobra.Command, args []string) error {
 	// Calculate stats from filtered issues
 	stats := calculateStats(issues)
 
-	printStats(stats, filterDescription)
+	warnings := store.Warnings()
+	printStats(stats, filterDescription, len(warnings))
+
+	if statsActivity {
+		printActivity(allIssues, time.Now())
+	}
+
+	if statsBy != "" {
+		printThroughput(issues, statsBy, time.Now())
+	}
+
+	if statsFailures && len(warnings) > 0 {
+		printParseWarnings(warnings)
+	}
 	return nil
 }
 
+// runMultiProjectStats shows the combined statistics of all projects
+// followed by a per-project breakdown.
+func runMultiProjectStats(cmd *cobra.Command) error {
+	if !statsDateFilter.IsEmpty() || statsActivity || statsBy != "" {
+		return fmt.Errorf("date filters, --activity and --by are not supported with multiple projects")
+	}
+
+	multiStore, err := getMultiStore(cmd)
+	if err != nil {
+		return err
+	}
+	stats, err := multiStore.Stats()
+	if err != nil {
+		return err
+	}
+
+	var failures []issue.ParseFailure
+	for _, w := range multiStore.Warnings() {
+		failures = append(failures, w.ParseFailure)
+	}
+
+	printStats(stats.Combined, fmt.Sprintf("%d projects", len(stats.Projects)), len(failures))
+	printProjectStats(stats)
+
+	if statsFailures && len(failures) > 0 {
+		printParseWarnings(failures)
+	}
+	return nil
+}
+
+// printProjectStats prints issue counts per state for each project.
+func printProjectStats(stats *project.MultiStats) {
+	states := issue.AllStates()
+
+	fmt.Printf("\n%sBy Project:\n", glyph("📦 ", ""))
+	header := fmt.Sprintf("  %-15s", "project")
+	for _, state := range states {
+		header += fmt.Sprintf(" %6s", state)
+	}
+	fmt.Println(colorize(header+fmt.Sprintf(" %6s", "total"), colorGray))
+
+	row := func(name string, s *issue.Stats) {
+		line := fmt.Sprintf("  %-15s", name)
+		for _, state := range states {
+			line += fmt.Sprintf(" %6d", s.ByState[state])
+		}
+		fmt.Printf("%s %6d\n", line, s.Total)
+	}
+	for _, alias := range stats.Projects {
+		row(alias, stats.ByProject[alias])
+	}
+	row("total", stats.Combined)
+
+	fmt.Println("\n" + hrule("━"))
+}
+
 // calculateStats computes statistics from a list of issues
 func calculateStats(issues []*issue.Issue) *issue.Stats {
 	stats := &issue.Stats{
-		Total:      len(issues),
-		ByState:    make(map[issue.State]int),
-		ByLabel:    make(map[string]int),
-		ByAssignee: make(map[string]int),
+		Total:         len(issues),
+		ByState:       make(map[issue.State]int),
+		ByLabel:       make(map[string]int),
+		ByAssignee:    make(map[string]int),
+		PointsByState: make(map[issue.State]int),
 	}
 
 	for _, iss := range issues {
 		stats.ByState[iss.State]++
+		stats.PointsByState[iss.State] += iss.Points
 
 		for _, label := range iss.Labels {
 			stats.ByLabel[label]++
@@ -118,36 +214,52 @@ func getFilterDescription(filter *DateFilter) string {
 	return ""
 }
 
-func printStats(stats *issue.Stats, filterDescription string) {
-	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+func printStats(stats *issue.Stats, filterDescription string, failureCount int) {
+	fmt.Println(hrule("━"))
 	if filterDescription != "" {
 		fmt.Printf("            Issue Statistics (%s)\n", filterDescription)
 	} else {
 		fmt.Println("                    Issue Statistics")
 	}
-	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+	fmt.Println(hrule("━"))
 
-	fmt.Printf("\n📊 Total Issues: %d\n", stats.Total)
+	fmt.Printf("\n%sTotal Issues: %d\n", glyph("📊 ", ""), stats.Total)
 
 	// 상태별 통계
-	fmt.Println("\n📁 By State:")
-	stateOrder := []issue.State{issue.StateOpen, issue.StateWip, issue.StateDone, issue.StateClosed}
+	fmt.Printf("\n%sBy State:\n", glyph("📁 ", ""))
 	stateEmoji := map[issue.State]string{
-		issue.StateOpen:   "○",
-		issue.StateWip:    "◐",
-		issue.StateDone:   "●",
-		issue.StateClosed: "✕",
+		issue.StateOpen:   glyph("○", "o"),
+		issue.StateWip:    glyph("◐", "~"),
+		issue.StateDone:   glyph("●", "*"),
+		issue.StateClosed: glyph("✕", "x"),
 	}
 
-	for _, state := range stateOrder {
+	for _, state := range issue.AllStates() {
+		emoji, ok := stateEmoji[state]
+		if !ok {
+			emoji = glyph("◇", "-")
+		}
 		count := stats.ByState[state]
 		bar := makeBar(count, stats.Total, 20)
-		fmt.Printf("  %s %-12s %3d %s\n", stateEmoji[state], state, count, bar)
+		fmt.Printf("  %s %-12s %3d %s\n", emoji, state, count, bar)
+	}
+
+	// 포인트 통계 (추정치가 있는 경우만)
+	if committed := stats.CommittedPoints(); committed > 0 {
+		completed := stats.CompletedPoints()
+		fmt.Printf("\n%sPoints:\n", glyph("🎯 ", ""))
+		fmt.Printf("  %-14s %3d\n", "committed", committed)
+		fmt.Printf("  %-14s %3d %s\n", "completed", completed, makeBar(completed, committed, 20))
+		for _, state := range issue.ActiveStates() {
+			if points := stats.PointsByState[state]; points > 0 {
+				fmt.Printf("  %-14s %3d\n", state, points)
+			}
+		}
 	}
 
 	// 레이블별 통계
 	if len(stats.ByLabel) > 0 {
-		fmt.Println("\n🏷️  By Label:")
+		fmt.Printf("\n%sBy Label:\n", glyph("🏷️  ", ""))
 		labels := sortedMapKeys(stats.ByLabel)
 		for _, label := range labels {
 			count := stats.ByLabel[label]
@@ -158,7 +270,7 @@ func printStats(stats *issue.Stats, filterDescription string) {
 
 	// 담당자별 통계
 	if len(stats.ByAssignee) > 0 {
-		fmt.Println("\n👤 By Assignee:")
+		fmt.Printf("\n%sBy Assignee:\n", glyph("👤 ", ""))
 		assignees := sortedMapKeys(stats.ByAssignee)
 		for _, assignee := range assignees {
 			count := stats.ByAssignee[assignee]
@@ -167,7 +279,191 @@ func printStats(stats *issue.Stats, filterDescription string) {
 		}
 	}
 
-	fmt.Println("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+	// 파싱 실패 파일
+	if failureCount > 0 {
+		fmt.Println(colorize(fmt.Sprintf("\n%sParse failures: %d", glyph("⚠️  ", ""), failureCount), colorYellow))
+		if !statsFailures {
+			fmt.Println(colorize("  Run 'zap stats --failures' to list them", colorGray))
+		}
+	}
+
+	fmt.Println("\n" + hrule("━"))
+}
+
+// activityBuckets counts issues created and closed on each of the last
+// days days (local time), oldest first; the last bucket is today
+func activityBuckets(issues []*issue.Issue, now time.Time, days int) (created, closed []int) {
+	created = make([]int, days)
+	closed = make([]int, days)
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	bucket := func(t time.Time) int {
+		t = t.In(now.Location())
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, now.Location())
+		ago := int(today.Sub(day).Hours()+12) / 24 // round to absorb DST shifts
+		if ago < 0 || ago >= days {
+			return -1
+		}
+		return days - 1 - ago
+	}
+
+	for _, iss := range issues {
+		if !iss.CreatedAt.IsZero() {
+			if i := bucket(iss.CreatedAt); i >= 0 {
+				created[i]++
+			}
+		}
+		if iss.ClosedAt != nil {
+			if i := bucket(*iss.ClosedAt); i >= 0 {
+				closed[i]++
+			}
+		}
+	}
+
+	return created, closed
+}
+
+// sparkline renders counts as a one-character-per-value bar chart
+func sparkline(counts []int) string {
+	levels := []rune(glyph(" ▁▂▃▄▅▆▇█", " .:-=+*#@"))
+
+	peak := 0
+	for _, c := range counts {
+		if c > peak {
+			peak = c
+		}
+	}
+
+	var sb strings.Builder
+	for _, c := range counts {
+		idx := 0
+		if peak > 0 && c > 0 {
+			idx = 1 + c*(len(levels)-2)/peak
+		}
+		sb.WriteRune(levels[idx])
+	}
+	return sb.String()
+}
+
+// printActivity prints created/closed sparklines for the last activityDays days
+func printActivity(issues []*issue.Issue, now time.Time) {
+	created, closed := activityBuckets(issues, now, activityDays)
+
+	sum := func(counts []int) int {
+		total := 0
+		for _, c := range counts {
+			total += c
+		}
+		return total
+	}
+
+	start := now.AddDate(0, 0, -(activityDays - 1))
+	fmt.Printf("\n%sActivity (%s ~ %s):\n", glyph("📈 ", ""), start.Format("01-02"), now.Format("01-02"))
+	fmt.Printf("  %-8s %s %3d\n", "created", colorize(sparkline(created), colorCyan), sum(created))
+	fmt.Printf("  %-8s %s %3d\n", "closed", colorize(sparkline(closed), colorGreen), sum(closed))
+	fmt.Println("\n" + hrule("━"))
+}
+
+// throughputRow counts issues closed and created in one period
+type throughputRow struct {
+	Start   time.Time
+	Closed  int
+	Created int
+}
+
+// periodStart truncates t to the start of its week (Monday) or month,
+// in t's location
+func periodStart(t time.Time, by string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	if by == "month" {
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	offset := (int(day.Weekday()) + 6) % 7 // days since Monday
+	return day.AddDate(0, 0, -offset)
+}
+
+// nextPeriod returns the start of the period after start
+func nextPeriod(start time.Time, by string) time.Time {
+	if by == "month" {
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 7)
+}
+
+// throughputBuckets groups issues by the week or month they were closed in,
+// from the period of the earliest closed_at through the period of now
+// (local time), oldest first. Issues created in those periods are counted
+// too. Returns nil if no issue has a closed_at.
+func throughputBuckets(issues []*issue.Issue, by string, now time.Time) []throughputRow {
+	loc := now.Location()
+
+	var first time.Time
+	for _, iss := range issues {
+		if iss.ClosedAt != nil && (first.IsZero() || iss.ClosedAt.Before(first)) {
+			first = *iss.ClosedAt
+		}
+	}
+	if first.IsZero() {
+		return nil
+	}
+
+	var rows []throughputRow
+	index := make(map[time.Time]int)
+	last := periodStart(now.In(loc), by)
+	for start := periodStart(first.In(loc), by); !start.After(last); start = nextPeriod(start, by) {
+		index[start] = len(rows)
+		rows = append(rows, throughputRow{Start: start})
+	}
+
+	for _, iss := range issues {
+		if iss.ClosedAt != nil {
+			if i, ok := index[periodStart(iss.ClosedAt.In(loc), by)]; ok {
+				rows[i].Closed++
+			}
+		}
+		if !iss.CreatedAt.IsZero() {
+			if i, ok := index[periodStart(iss.CreatedAt.In(loc), by)]; ok {
+				rows[i].Created++
+			}
+		}
+	}
+
+	return rows
+}
+
+// printThroughput prints a table of issues closed and created per period
+func printThroughput(issues []*issue.Issue, by string, now time.Time) {
+	rows := throughputBuckets(issues, by, now)
+
+	fmt.Printf("\n%sThroughput by %s:\n", glyph("📈 ", ""), by)
+	if len(rows) == 0 {
+		fmt.Println(colorize("  No closed issues", colorGray))
+		fmt.Println("\n" + hrule("━"))
+		return
+	}
+
+	peak := 0
+	for _, row := range rows {
+		peak = max(peak, row.Closed)
+	}
+
+	header := "Week of"
+	if by == "month" {
+		header = "Month"
+	}
+	fmt.Printf("  %-10s %6s %7s\n", header, "Closed", "Created")
+	for _, row := range rows {
+		label := row.Start.Format("2006-01-02")
+		if by == "month" {
+			label = row.Start.Format("2006-01")
+		}
+		bar := ""
+		if peak > 0 && row.Closed > 0 {
+			bar = strings.Repeat(glyph("█", "#"), max(1, row.Closed*20/peak))
+		}
+		fmt.Printf("  %-10s %6d %7d  %s\n", label, row.Closed, row.Created, colorize(bar, colorGreen))
+	}
+	fmt.Println("\n" + hrule("━"))
 }
 
 func makeBar(count, total, width int) string {
@@ -182,10 +478,10 @@ func makeBar(count, total, width int) string {
 
 	bar := ""
 	for i := 0; i < filled; i++ {
-		bar += "█"
+		bar += glyph("█", "#")
 	}
 	for i := filled; i < width; i++ {
-		bar += "░"
+		bar += glyph("░", ".")
 	}
 
 	percentage := float64(count) * 100 / float64(total)
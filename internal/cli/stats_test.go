@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+func TestActivityBuckets(t *testing.T) {
+	now := time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC)
+	closedAt := now.Add(-2 * time.Hour)
+
+	issues := []*issue.Issue{
+		{Number: 1, CreatedAt: now.Add(-time.Hour)},
+		{Number: 2, CreatedAt: now.AddDate(0, 0, -1), ClosedAt: &closedAt},
+		{Number: 3, CreatedAt: now.AddDate(0, 0, -3)},
+		{Number: 4, CreatedAt: now.AddDate(0, 0, -30)},
+	}
+
+	created, closed := activityBuckets(issues, now, 4)
+
+	if got, want := fmt.Sprint(created), "[1 0 1 1]"; got != want {
+		t.Errorf("created = %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(closed), "[0 0 0 1]"; got != want {
+		t.Errorf("closed = %s, want %s", got, want)
+	}
+}
+
+func TestSparklinePlain(t *testing.T) {
+	plainOutput = true
+	defer func() { plainOutput = false }()
+
+	if got, want := sparkline([]int{0, 1, 2, 4}), " :=@"; got != want {
+		t.Errorf("sparkline() = %q, want %q", got, want)
+	}
+}
+
+func TestCalculateStatsPoints(t *testing.T) {
+	issues := []*issue.Issue{
+		{Number: 1, State: issue.StateOpen, Points: 3},
+		{Number: 2, State: issue.StateWip, Points: 2},
+		{Number: 3, State: issue.StateDone, Points: 5},
+		{Number: 4, State: issue.StateDone},
+		{Number: 5, State: issue.StateClosed, Points: 8},
+	}
+
+	stats := calculateStats(issues)
+
+	if got := stats.PointsByState[issue.StateDone]; got != 5 {
+		t.Errorf("PointsByState[done] = %d, want 5", got)
+	}
+	if got := stats.CommittedPoints(); got != 10 {
+		t.Errorf("CommittedPoints() = %d, want 10", got)
+	}
+	if got := stats.CompletedPoints(); got != 5 {
+		t.Errorf("CompletedPoints() = %d, want 5", got)
+	}
+}
+
+func TestPeriodStart(t *testing.T) {
+	thu := time.Date(2026, 10, 15, 18, 30, 0, 0, time.UTC)
+	sun := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	if got := periodStart(thu, "week").Format("2006-01-02 15:04"); got != "2026-10-12 00:00" {
+		t.Errorf("week of Thursday = %s", got)
+	}
+	if got := periodStart(sun, "week").Format("2006-01-02"); got != "2026-10-12" {
+		t.Errorf("week of Sunday = %s, want the preceding Monday", got)
+	}
+	if got := periodStart(thu, "month").Format("2006-01-02 15:04"); got != "2026-10-01 00:00" {
+		t.Errorf("month = %s", got)
+	}
+}
+
+func TestThroughputBuckets(t *testing.T) {
+	now := time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC)
+	at := func(y int, m time.Month, d int) *time.Time {
+		t := time.Date(y, m, d, 12, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	issues := []*issue.Issue{
+		{Number: 1, CreatedAt: *at(2026, 8, 1), ClosedAt: at(2026, 8, 20)},
+		{Number: 2, CreatedAt: *at(2026, 8, 25), ClosedAt: at(2026, 10, 2)},
+		{Number: 3, CreatedAt: *at(2026, 10, 3), ClosedAt: at(2026, 10, 14)},
+		{Number: 4, CreatedAt: *at(2026, 10, 10)},
+		{Number: 5, CreatedAt: *at(2026, 1, 5)}, // before the first closed period
+	}
+
+	rows := throughputBuckets(issues, "month", now)
+	var got []string
+	for _, row := range rows {
+		got = append(got, fmt.Sprintf("%s:%d/%d", row.Start.Format("2006-01"), row.Closed, row.Created))
+	}
+	want := []string{"2026-08:1/2", "2026-09:0/0", "2026-10:2/2"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("month rows = %v, want %v", got, want)
+	}
+
+	weeks := throughputBuckets(issues, "week", now)
+	if len(weeks) != 9 {
+		t.Errorf("week rows = %d, want 9 (weeks of 08-17 through 10-12)", len(weeks))
+	}
+
+	if rows := throughputBuckets([]*issue.Issue{{Number: 1, CreatedAt: now}}, "week", now); rows != nil {
+		t.Errorf("no closed issues: got %v, want nil", rows)
+	}
+}
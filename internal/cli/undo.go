@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// undoFileName is the undo log inside the issues directory. It holds full
+// copies of the changed files, so it lives in the git-ignored cache.
+var undoFileName = filepath.Join(cacheDir, "undo")
+
+var undoCmd = &cobra.Command{
+	Use:   "undo",
+	Short: "Revert the last file-changing operation",
+	Long: `Revert the most recent operation that changed issue files.
+
+set, mv, repair, fix-numbers and validate --fix record the previous content
+of every file they touch in .issues/.cache/undo. 'zap undo' restores those files.
+Only the last operation is kept, and undo refuses to run if any of the files
+has been changed since.
+
+Examples:
+  zap set done 3
+  zap undo          # Issue #3 is back in its previous state`,
+	Args: cobra.NoArgs,
+	RunE: runUndo,
+}
+
+var undoDryRun bool
+
+func init() {
+	rootCmd.AddCommand(undoCmd)
+	undoCmd.Flags().BoolVar(&undoDryRun, "dry-run", false, "Show what would be restored without making changes")
+}
+
+// undoRecord is the on-disk format of the undo log.
+type undoRecord struct {
+	Operation string      `json:"operation"`
+	At        time.Time   `json:"at"`
+	Files     []*undoFile `json:"files"`
+}
+
+// undoFile is the state of one file before and after an operation.
+// Paths are relative to the issues directory.
+type undoFile struct {
+	Path   string  `json:"path"`
+	Before *string `json:"before"`          // nil if the file did not exist
+	After  string  `json:"after,omitempty"` // content hash after the operation ("" if removed)
+}
+
+// undoRecorder snapshots files before an operation changes them.
+type undoRecorder struct {
+	dir    string
+	record *undoRecord
+	seen   map[string]bool
+}
+
+// newUndoRecorder starts recording an operation in the given issues directory.
+func newUndoRecorder(dir, operation string) *undoRecorder {
+	return &undoRecorder{
+		dir:    dir,
+		record: &undoRecord{Operation: operation},
+		seen:   make(map[string]bool),
+	}
+}
+
+// track snapshots a file before it is modified, renamed or created.
+// Call it for both the old and new path of a rename.
+func (r *undoRecorder) track(path string) {
+	rel, err := filepath.Rel(r.dir, path)
+	if err != nil || r.seen[rel] {
+		return
+	}
+	r.seen[rel] = true
+
+	f := &undoFile{Path: rel}
+	if data, err := os.ReadFile(path); err == nil {
+		content := string(data)
+		f.Before = &content
+	}
+	r.record.Files = append(r.record.Files, f)
+}
+
+// save writes the undo log if any tracked file changed.
+// An operation that changed nothing leaves the previous undo log in place.
+func (r *undoRecorder) save() error {
+	// Keep only files the operation actually changed
+	var changed []*undoFile
+	for _, f := range r.record.Files {
+		f.After = hashFile(filepath.Join(r.dir, f.Path))
+
+		before := ""
+		if f.Before != nil {
+			before = hashContent(*f.Before)
+		}
+		if before != f.After {
+			changed = append(changed, f)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	r.record.Files = changed
+
+	r.record.At = time.Now().UTC()
+	data, err := json.MarshalIndent(r.record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize undo log: %w", err)
+	}
+
+	if err := writeCacheFile(r.dir, filepath.Base(undoFileName), data); err != nil {
+		return fmt.Errorf("failed to write undo log: %w", err)
+	}
+	return nil
+}
+
+// saveOrWarn saves the undo log, printing a warning instead of failing
+// the operation that has already been applied.
+func (r *undoRecorder) saveOrWarn() {
+	if err := r.save(); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  %v (zap undo will not be available)\n", err)
+	}
+}
+
+func runUndo(cmd *cobra.Command, args []string) error {
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, undoFileName)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("nothing to undo")
+		}
+		return err
+	}
+
+	var record undoRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return fmt.Errorf("failed to parse undo log: %w", err)
+	}
+
+	// Refuse if anything changed since the operation
+	for _, f := range record.Files {
+		if hashFile(filepath.Join(dir, f.Path)) != f.After {
+			return fmt.Errorf("cannot undo %q: %s has changed since", record.Operation, f.Path)
+		}
+	}
+
+	fmt.Printf("Undoing %q from %s\n", record.Operation, record.At.Local().Format("2006-01-02 15:04"))
+
+	if undoDryRun {
+		for _, f := range record.Files {
+			fmt.Printf("  Would %s\n", describeUndoFile(f))
+		}
+		return nil
+	}
+
+	if err := restoreUndoRecord(dir, &record); err != nil {
+		return err
+	}
+
+	for _, f := range record.Files {
+		fmt.Printf("  %s\n", describeUndoFile(f))
+	}
+
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove undo log: %w", err)
+	}
+
+	fmt.Println("✅ Undo complete")
+	return nil
+}
+
+// restoreUndoRecord puts every file in the record back to its prior content.
+// Files that did not exist before the operation are removed.
+func restoreUndoRecord(dir string, record *undoRecord) error {
+	for _, f := range record.Files {
+		path := filepath.Join(dir, f.Path)
+
+		if f.Before == nil {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to remove %s: %w", f.Path, err)
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.WriteFile(path, []byte(*f.Before), 0644); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", f.Path, err)
+		}
+	}
+	return nil
+}
+
+// describeUndoFile returns a one-line description of what restoring f does.
+func describeUndoFile(f *undoFile) string {
+	switch {
+	case f.Before == nil:
+		return "remove " + f.Path
+	case f.After == "":
+		return "recreate " + f.Path
+	default:
+		return "restore " + f.Path
+	}
+}
+
+// hashFile returns the content hash of a file, or "" if it does not exist.
+func hashFile(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return hashContent(string(data))
+}
+
+// hashContent returns the hex SHA-256 of content.
+func hashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUndoRecorderRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "001-old.md")
+	newPath := filepath.Join(dir, "001-new.md")
+	untouched := filepath.Join(dir, "002-other.md")
+
+	for path, content := range map[string]string{oldPath: "original", untouched: "other"} {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	undo := newUndoRecorder(dir, "mv 1")
+	undo.track(oldPath)
+	undo.track(newPath)
+	undo.track(untouched)
+
+	// Simulate a rename with a content change
+	if err := os.WriteFile(oldPath, []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(oldPath, newPath); err != nil {
+		t.Fatal(err)
+	}
+	if err := undo.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, undoFileName))
+	if err != nil {
+		t.Fatalf("undo log not written: %v", err)
+	}
+	if ignore, err := os.ReadFile(filepath.Join(dir, cacheDir, ".gitignore")); err != nil || string(ignore) != "*\n" {
+		t.Errorf("cache .gitignore = %q, %v", ignore, err)
+	}
+	var record undoRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		t.Fatal(err)
+	}
+	if len(record.Files) != 2 {
+		t.Fatalf("expected 2 changed files in undo log, got %d", len(record.Files))
+	}
+
+	if err := restoreUndoRecord(dir, &record); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := os.ReadFile(oldPath); err != nil || string(got) != "original" {
+		t.Errorf("old file not restored: %q, %v", got, err)
+	}
+	if _, err := os.Stat(newPath); !os.IsNotExist(err) {
+		t.Errorf("new file should be removed, stat err = %v", err)
+	}
+}
+
+func TestUndoRecorderNoChange(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "001-test.md")
+	if err := os.WriteFile(path, []byte("same"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	undo := newUndoRecorder(dir, "set open 1")
+	undo.track(path)
+	if err := undo.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, undoFileName)); !os.IsNotExist(err) {
+		t.Errorf("undo log should not be written when nothing changed")
+	}
+}
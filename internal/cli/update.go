@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/itda-work/zap/internal/updater"
 	"github.com/spf13/cobra"
@@ -19,11 +20,12 @@ const (
 )
 
 var (
-	updateCheck   bool
-	updateForce   bool
-	updateYes     bool
-	updateVersion string
-	updateScript  bool
+	updateCheck    bool
+	updateForce    bool
+	updateYes      bool
+	updateVersion  string
+	updateScript   bool
+	updateRollback bool
 )
 
 var updateCmd = &cobra.Command{
@@ -34,11 +36,17 @@ var updateCmd = &cobra.Command{
 
 Examples:
   zap update              # Check and update interactively
-  zap update --check      # Check for updates only
-  zap update -y           # Update without confirmation
-  zap update --force      # Update without confirmation (same as -y)
+  zap update --check      # Check for updates only (cached for 24h)
+  zap update --check -f   # Check GitHub now, ignoring the cache
+  zap update -y           # Update without confirmation (ignores the cache)
+  zap update --force      # Same as -y
   zap update -v v0.3.0    # Update to a specific version
-  zap update --script     # Update using OS install script (curl/PowerShell)`,
+  zap update --script     # Update using OS install script (curl/PowerShell)
+  zap update --rollback   # Restore the binary replaced by the last update
+
+Each update keeps the replaced binary next to zap as <exec>.prev. Only one
+version is kept: --rollback restores it and removes it, and the next update
+replaces it.`,
 	RunE: runUpdate,
 }
 
@@ -46,13 +54,21 @@ func init() {
 	rootCmd.AddCommand(updateCmd)
 
 	updateCmd.Flags().BoolVarP(&updateCheck, "check", "c", false, "Check for updates only, do not install")
-	updateCmd.Flags().BoolVarP(&updateForce, "force", "f", false, "Update without confirmation")
+	updateCmd.Flags().BoolVarP(&updateForce, "force", "f", false, "Update without confirmation and ignore the cached update check")
 	updateCmd.Flags().BoolVarP(&updateYes, "yes", "y", false, "Update without confirmation (alias for --force)")
 	updateCmd.Flags().StringVarP(&updateVersion, "version", "v", "", "Update to a specific version")
 	updateCmd.Flags().BoolVar(&updateScript, "script", false, "Update using OS-specific install script (curl/PowerShell)")
+	updateCmd.Flags().BoolVar(&updateRollback, "rollback", false, "Restore the binary replaced by the last update")
 }
 
 func runUpdate(cmd *cobra.Command, args []string) error {
+	if updateRollback {
+		if updateCheck || updateScript || updateVersion != "" {
+			return fmt.Errorf("--rollback cannot be used with --check, --script or --version")
+		}
+		return runRollback()
+	}
+
 	// Use install script if --script flag is set
 	if updateScript {
 		return runScriptUpdate()
@@ -74,7 +90,7 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 	if updateVersion != "" {
 		info, err = u.CheckForUpdateToVersion(updateVersion)
 	} else {
-		info, err = u.CheckForUpdate()
+		info, err = checkLatest(u, updateForce || updateYes)
 	}
 
 	if err != nil {
@@ -83,7 +99,11 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 
 	// Display version info
 	fmt.Printf("Current version: %s\n", info.CurrentVersion)
-	fmt.Printf("Latest version:  %s\n", info.LatestVersion)
+	latest := info.LatestVersion
+	if !info.CheckedAt.IsZero() && time.Since(info.CheckedAt) > time.Minute {
+		latest += " " + colorize("(checked "+formatRelativeTime(info.CheckedAt)+", use -f to recheck)", colorGray)
+	}
+	fmt.Printf("Latest version:  %s\n", latest)
 	fmt.Println()
 
 	// No update available
@@ -142,6 +162,60 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runRollback restores the binary replaced by the last update after
+// checking that it runs.
+func runRollback() error {
+	u, err := updater.NewUpdater(Version)
+	if err != nil {
+		return fmt.Errorf("initialize updater: %w", err)
+	}
+
+	previous, err := u.CheckPrevious()
+	if err != nil {
+		var noPrevErr *updater.NoPreviousError
+		if errors.As(err, &noPrevErr) {
+			fmt.Println("Only the binary replaced by the last update is kept, and there is none.")
+			fmt.Println("To install a specific version, use:")
+			fmt.Println("  zap update -v <version>")
+		}
+		return err
+	}
+	previous = strings.TrimPrefix(previous, "zap version ")
+
+	fmt.Printf("Current version:  %s\n", Version)
+	fmt.Printf("Previous version: %s\n", previous)
+	fmt.Println()
+
+	canUpdate, reason := u.CanSelfUpdate()
+	if !canUpdate {
+		return handlePermissionError(reason, u.ExecPath())
+	}
+
+	if !updateForce && !updateYes {
+		if !confirmYesDefault(fmt.Sprintf("Roll back zap %s → %s?", Version, previous)) {
+			fmt.Println("Rollback cancelled.")
+			return nil
+		}
+	}
+
+	if err := u.Rollback(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Rolled back to %s. Run 'zap update' to return to the latest version.\n", previous)
+	return nil
+}
+
+// checkLatest checks for the latest release, reusing the result cached in
+// the user cache directory for a day unless force is set.
+func checkLatest(u *updater.Updater, force bool) (*updater.UpdateInfo, error) {
+	cachePath, err := updater.DefaultCachePath()
+	if err != nil {
+		return u.CheckForUpdate()
+	}
+	return u.CheckForUpdateCached(cachePath, force)
+}
+
 func handleDevBuild() error {
 	fmt.Printf("Current version: %s\n\n", Version)
 	fmt.Println("You're running a development build. Cannot determine update status.")
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/itda-work/zap/internal/config"
+	"github.com/itda-work/zap/internal/updater"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+// updateNoticeWait is how long a command waits at exit for the background
+// update check. If it isn't done by then, no notice is printed; the result
+// is still cached and shown by a later command.
+const updateNoticeWait = 300 * time.Millisecond
+
+// updateNotice receives the result of the background update check, or is
+// nil when no check was started.
+var updateNotice chan *updater.UpdateInfo
+
+// startUpdateCheck starts a background check for a new release when
+// update_check is enabled in .zap.yml and the last check is a day old.
+// Between checks the notice comes from the cached release.
+// It never fails the command; any problem just skips the check.
+func startUpdateCheck(cmd *cobra.Command) {
+	if cmd == updateCmd || cmd == versionCmd || updater.IsDevVersion(Version) {
+		return
+	}
+	// Shell completion output is parsed by the shell
+	if name := cmd.Name(); name == cobra.ShellCompRequestCmd || name == cobra.ShellCompNoDescRequestCmd || isCompletionCmd(cmd) {
+		return
+	}
+	if !isatty.IsTerminal(os.Stderr.Fd()) {
+		return
+	}
+	if quiet, err := cmd.Flags().GetBool("quiet"); err == nil && quiet {
+		return
+	}
+
+	dir, _, _, err := discoverIssuesDir(cmd)
+	if err != nil {
+		return
+	}
+	cfg, err := config.Load(dir)
+	if err != nil || !cfg.UpdateCheck {
+		return
+	}
+
+	cachePath, err := updater.DefaultCachePath()
+	if err != nil {
+		return
+	}
+
+	ch := make(chan *updater.UpdateInfo, 1)
+	if !updater.CheckDue(cachePath, time.Now()) {
+		if release := updater.CachedRelease(cachePath); release != nil {
+			ch <- &updater.UpdateInfo{
+				CurrentVersion:  Version,
+				LatestVersion:   release.TagName,
+				UpdateAvailable: updater.CompareVersions(Version, release.TagName) < 0,
+				ReleaseInfo:     release,
+			}
+			updateNotice = ch
+		}
+		return
+	}
+	u, err := updater.NewUpdater(Version)
+	if err != nil {
+		return
+	}
+
+	updateNotice = ch
+	go func() {
+		info, err := u.CheckForUpdateCached(cachePath, false)
+		if err != nil {
+			info = nil
+		}
+		ch <- info
+	}()
+}
+
+// printUpdateNotice prints a one-line hint on stderr if the background
+// check found a newer release.
+func printUpdateNotice() {
+	if updateNotice == nil {
+		return
+	}
+
+	select {
+	case info := <-updateNotice:
+		if info != nil && info.UpdateAvailable {
+			fmt.Fprintln(os.Stderr, colorize(fmt.Sprintf("zap %s is available (current %s). Run 'zap update' to install.",
+				info.LatestVersion, info.CurrentVersion), colorYellow))
+		}
+	case <-time.After(updateNoticeWait):
+	}
+}
+
+// isCompletionCmd reports whether cmd is cobra's completion command or one
+// of its per-shell subcommands.
+func isCompletionCmd(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c.Name() == "completion" && c.Parent() == c.Root() {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,8 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -23,6 +25,113 @@ const (
 	EnvRecentClosedMinutes = "ZAP_RECENT_CLOSED_MINUTES"
 )
 
+// meAlias is the assignee value that resolves to the current git user
+const meAlias = "@me"
+
+// gitConfigValue returns a git config value, or "" if unset or git is unavailable.
+// It is a variable so tests can stub it.
+var gitConfigValue = func(key string) string {
+	out, err := exec.Command("git", "config", "--get", key).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// resolveMe returns the current user from git config (user.name, then user.email)
+func resolveMe() (string, error) {
+	for _, key := range []string{"user.name", "user.email"} {
+		if v := gitConfigValue(key); v != "" {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("cannot resolve %s: git config user.name and user.email are not set", meAlias)
+}
+
+// resolveAssignee expands @me to the current git user; other values are returned as-is
+func resolveAssignee(name string) (string, error) {
+	if name != meAlias {
+		return name, nil
+	}
+	return resolveMe()
+}
+
+// buildIssueFilter builds the label/assignee filter shared by list and watch.
+// unassigned selects issues nobody owns and excludes assignees.
+// Values may be comma-separated or repeated; @me is expanded for assignees.
+func buildIssueFilter(labels, assignees []string, match string, unassigned bool) (issue.Filter, error) {
+	filter := issue.Filter{Labels: trimNonEmpty(labels), Unassigned: unassigned}
+	if unassigned && len(trimNonEmpty(assignees)) > 0 {
+		return filter, fmt.Errorf("--unassigned cannot be combined with --assignee")
+	}
+
+	switch match {
+	case "any", "":
+	case "all":
+		filter.MatchAll = true
+	default:
+		return filter, fmt.Errorf("invalid --match: %s (valid: any, all)", match)
+	}
+
+	for _, name := range trimNonEmpty(assignees) {
+		resolved, err := resolveAssignee(name)
+		if err != nil {
+			return filter, err
+		}
+		filter.Assignees = append(filter.Assignees, resolved)
+	}
+	return filter, nil
+}
+
+// addIssueExclusions adds the --exclude-label/--exclude-assignee values to
+// a filter. Exclusions apply after the positive filters; @me is expanded.
+func addIssueExclusions(filter issue.Filter, labels, assignees []string) (issue.Filter, error) {
+	filter.ExcludeLabels = trimNonEmpty(labels)
+	for _, name := range trimNonEmpty(assignees) {
+		resolved, err := resolveAssignee(name)
+		if err != nil {
+			return filter, err
+		}
+		filter.ExcludeAssignees = append(filter.ExcludeAssignees, resolved)
+	}
+	return filter, nil
+}
+
+// printPorcelain prints fields as a single tab-separated line. It is the
+// stable, script-friendly output of --porcelain.
+func printPorcelain(fields ...any) {
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		parts[i] = fmt.Sprint(f)
+	}
+	fmt.Println(strings.Join(parts, "\t"))
+}
+
+// trimNonEmpty trims values and drops empty ones.
+func trimNonEmpty(values []string) []string {
+	var out []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// filterProjectIssues applies an issue filter in multi-project mode.
+func filterProjectIssues(issues []*project.ProjectIssue, filter issue.Filter) []*project.ProjectIssue {
+	if filter.IsEmpty() {
+		return issues
+	}
+	var results []*project.ProjectIssue
+	for _, pIss := range issues {
+		if filter.Match(pIss.Issue) {
+			results = append(results, pIss)
+		}
+	}
+	return results
+}
+
 // getRecentClosedDuration returns the duration for which recently closed/done issues should be displayed.
 // It reads from ZAP_RECENT_CLOSED_MINUTES environment variable, defaulting to 5 minutes.
 func getRecentClosedDuration() time.Duration {
@@ -36,7 +145,7 @@ func getRecentClosedDuration() time.Duration {
 
 // isRecentlyClosed checks if an issue was recently closed (done or closed state) within the given duration.
 func isRecentlyClosed(updatedAt time.Time, state string, duration time.Duration) bool {
-	if state != "done" && state != "closed" {
+	if def, ok := issue.LookupState(issue.State(state)); !ok || !def.Terminal {
 		return false
 	}
 	return time.Since(updatedAt) <= duration
@@ -73,12 +182,15 @@ func confirmYesDefault(prompt string) bool {
 	return false
 }
 
-// getAIClient returns an AI client based on the provided flag or auto-detection.
-func getAIClient(aiFlag string) (ai.Client, error) {
+// getAIClient returns an AI client based on the provided flag or the provider
+// cached for the issues directory (auto-detected on first use).
+// If modelFlag is set, it overrides the configured model of the selected provider.
+func getAIClient(dir, aiFlag, modelFlag string) (ai.Client, error) {
 	cfg, err := ai.LoadConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load AI config: %w", err)
 	}
+	cfg.OverrideModel(modelFlag)
 
 	if aiFlag != "" {
 		provider, ok := ai.ParseProvider(aiFlag)
@@ -89,15 +201,15 @@ func getAIClient(aiFlag string) (ai.Client, error) {
 		if client == nil || !client.IsAvailable() {
 			return nil, fmt.Errorf("%s CLI is not installed or not available", aiFlag)
 		}
-		return client, nil
+		return ai.NewRetryClient(client, cfg.Retries), nil
 	}
 
-	// Auto-detect
-	client, err := ai.AutoDetect(cfg)
+	// Cached or auto-detected
+	client, err := selectAIClient(dir, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("no AI CLI available. Install one of: claude, codex, gemini")
 	}
-	return client, nil
+	return ai.NewRetryClient(client, cfg.Retries), nil
 }
 
 // formatRelativeTime formats a time as relative time string (e.g., "2 hr ago", "3 days ago")
@@ -150,20 +262,28 @@ func formatRelativeTime(t time.Time) string {
 
 // statePriority returns the priority for sorting issues by state.
 // Lower value = appears first in the list.
-// Order: done(0) → closed(1) → wip(2) → open(3)
+// Terminal states come first in their configured order, then the others
+// from the last configured one back: done(0) → closed(1) → wip(2) → open(3)
 func statePriority(state issue.State) int {
-	switch state {
-	case issue.StateDone:
-		return 0
-	case issue.StateClosed:
-		return 1
-	case issue.StateWip:
-		return 2
-	case issue.StateOpen:
-		return 3
-	default:
-		return 4
+	states := issue.States()
+	priority := 0
+	for _, d := range states {
+		if d.Terminal {
+			if d.Name == state {
+				return priority
+			}
+			priority++
+		}
 	}
+	for i := len(states) - 1; i >= 0; i-- {
+		if !states[i].Terminal {
+			if states[i].Name == state {
+				return priority
+			}
+			priority++
+		}
+	}
+	return priority
 }
 
 // sortIssuesByStateAndTime sorts issues by state priority, then by UpdatedAt descending.
@@ -194,14 +314,41 @@ func sortProjectIssuesByStateAndTime(issues []*project.ProjectIssue) {
 	})
 }
 
-// getTerminalWidth returns the current terminal width.
-// Falls back to 80 columns if detection fails.
+// EnvWidth is the environment variable that forces the output width
+const EnvWidth = "ZAP_WIDTH"
+
+// defaultOutputWidth is used when stdout is not a terminal and no width is set
+const defaultOutputWidth = 120
+
+// outputWidth is set by the global --width flag (0 = auto).
+var outputWidth int
+
+// getTerminalWidth returns the width output is fitted to: --width, then
+// ZAP_WIDTH, then the terminal width. Redirected output without either
+// setting uses defaultOutputWidth.
 func getTerminalWidth() int {
+	if width, ok := detectOutputWidth(); ok {
+		return width
+	}
+	return defaultOutputWidth
+}
+
+// detectOutputWidth returns --width, ZAP_WIDTH or the terminal width, and
+// false if none of them is available.
+func detectOutputWidth() (int, bool) {
+	if outputWidth > 0 {
+		return outputWidth, true
+	}
+	if val := os.Getenv(EnvWidth); val != "" {
+		if width, err := strconv.Atoi(val); err == nil && width > 0 {
+			return width, true
+		}
+	}
 	width, _, err := term.GetSize(int(os.Stdout.Fd()))
 	if err != nil || width <= 0 {
-		return 80
+		return 0, false
 	}
-	return width
+	return width, true
 }
 
 // truncateLine truncates a string containing ANSI escape codes to fit within
@@ -271,3 +418,24 @@ func truncateLine(s string, maxWidth int) string {
 
 	return result.String()
 }
+
+// cacheDir holds machine-local state inside the issues directory, such as
+// the cached AI provider and the undo log.
+const cacheDir = ".cache"
+
+// writeCacheFile writes name inside the cache directory of the issues
+// directory dir. The cache directory gets a .gitignore so its contents are
+// not committed along with the issues.
+func writeCacheFile(dir, name string, data []byte) error {
+	cache := filepath.Join(dir, cacheDir)
+	if err := os.MkdirAll(cache, 0755); err != nil {
+		return err
+	}
+	ignore := filepath.Join(cache, ".gitignore")
+	if _, err := os.Stat(ignore); os.IsNotExist(err) {
+		if err := os.WriteFile(ignore, []byte("*\n"), 0644); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filepath.Join(cache, name), data, 0644)
+}
@@ -0,0 +1,287 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/itda-work/zap/internal/issue"
+	"github.com/spf13/cobra"
+)
+
+// validateLongFormat is the Long help of validate; %s is the list of states.
+const validateLongFormat = `Check every issue file against stricter rules than parsing alone.
+
+Rules:
+  parse             file must parse (error)
+  title-required    title must not be empty (error)
+  state-valid       state must be one of: %s (error)
+  number-filename   number must match the filename prefix (error)
+  field-value       known fields such as blocks must have a usable value (warning)
+  labels-lowercase  labels should be lowercase (warning, fixable)
+  dates-rfc3339     created_at, updated_at and closed_at should be RFC3339 (warning, fixable)
+  attachment-url    attachment URLs should look like a URL or file path (warning)
+
+Exits non-zero if any error-level violation is found, so it can gate CI.
+With --fix, fixable violations are corrected in place ('zap undo' reverts).
+
+Examples:
+  zap validate          # Report violations
+  zap validate --fix    # Lowercase labels and normalize dates`
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Lint issue files against the frontmatter schema",
+	Long:  fmt.Sprintf(validateLongFormat, issue.ValidStates()),
+	Args:  cobra.NoArgs,
+	RunE:  runValidate,
+}
+
+var validateFix bool
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+	validateCmd.Flags().BoolVar(&validateFix, "fix", false, "Auto-correct fixable violations")
+}
+
+// validateSeverity is the level of a validation rule
+type validateSeverity string
+
+const (
+	severityError   validateSeverity = "error"
+	severityWarning validateSeverity = "warning"
+)
+
+// violation is a single rule failure in an issue file
+type violation struct {
+	File     string
+	Rule     string
+	Severity validateSeverity
+	Message  string
+	Fixable  bool
+}
+
+func runValidate(cmd *cobra.Command, args []string) error {
+	dir, err := getIssuesDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	files, err := issueFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	var recorder *undoRecorder
+	if validateFix {
+		recorder = newUndoRecorder(dir, "validate --fix")
+	}
+
+	var violations []violation
+	fixed := 0
+	for _, path := range files {
+		found := validateFile(path)
+
+		if validateFix && hasFixable(found) {
+			recorder.track(path)
+			if err := fixIssueFile(path); err != nil {
+				fmt.Fprintf(os.Stderr, "❌ %s: %v\n", filepath.Base(path), err)
+			} else {
+				fixed++
+				found = validateFile(path)
+			}
+		}
+
+		violations = append(violations, found...)
+	}
+
+	if validateFix {
+		recorder.saveOrWarn()
+	}
+
+	errors := 0
+	for _, v := range violations {
+		icon := glyph("⚠️ ", "!")
+		color := colorYellow
+		if v.Severity == severityError {
+			icon = glyph("❌", "x")
+			color = colorRed
+			errors++
+		}
+		fmt.Printf("%s %s %s %s\n", icon, v.File, colorize("["+v.Rule+"]", color), v.Message)
+	}
+
+	if fixed > 0 {
+		fmt.Printf("\nFixed %d file(s).\n", fixed)
+	}
+
+	if len(violations) == 0 {
+		fmt.Printf("✅ %d files valid.\n", len(files))
+		return nil
+	}
+
+	fmt.Printf("\n%d file(s) checked: %d error(s), %d warning(s)\n", len(files), errors, len(violations)-errors)
+	if errors > 0 {
+		return fmt.Errorf("validation failed with %d error(s)", errors)
+	}
+	return nil
+}
+
+// issueFiles returns all issue files in the flat directory and legacy state
+// directories, sorted by path. Unlike Store.List it does not filter by state.
+func issueFiles(dir string) ([]string, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("issues directory not found: %s", dir)
+	}
+
+	patterns := []string{filepath.Join(dir, "*.md")}
+	for _, state := range issue.AllStates() {
+		patterns = append(patterns, filepath.Join(dir, issue.StateDir(state), "*.md"))
+	}
+
+	var files []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// validateFile checks a single issue file against all rules
+func validateFile(path string) []violation {
+	name := filepath.Base(path)
+	add := func(vs []violation, rule string, sev validateSeverity, fixable bool, format string, a ...any) []violation {
+		return append(vs, violation{
+			File:     name,
+			Rule:     rule,
+			Severity: sev,
+			Message:  fmt.Sprintf(format, a...),
+			Fixable:  fixable,
+		})
+	}
+
+	iss, err := issue.Parse(path)
+	if err != nil {
+		return add(nil, "parse", severityError, false, "%v", err)
+	}
+
+	var vs []violation
+	if strings.TrimSpace(iss.Title) == "" {
+		vs = add(vs, "title-required", severityError, false, "title is empty")
+	}
+
+	if _, ok := issue.ParseState(string(iss.State)); !ok {
+		vs = add(vs, "state-valid", severityError, false, "invalid state %q", iss.State)
+	}
+
+	if num := issue.NumberFromFilename(name); num != iss.Number {
+		vs = add(vs, "number-filename", severityError, false, "number %d does not match filename", iss.Number)
+	}
+
+	for _, w := range iss.ParseWarnings {
+		vs = add(vs, "field-value", severityWarning, false, "%s", w)
+	}
+
+	for _, label := range iss.Labels {
+		if label != strings.ToLower(label) {
+			vs = add(vs, "labels-lowercase", severityWarning, true, "label %q is not lowercase", label)
+		}
+	}
+
+	for _, a := range iss.Attachments {
+		if !a.HasValidURL() {
+			vs = add(vs, "attachment-url", severityWarning, false, "attachment %q has an invalid URL %q", a.Label(), a.URL)
+		}
+	}
+
+	raw, err := issue.GetRawDatetimeInfo(path)
+	if err == nil {
+		fields := []struct{ name, value string }{
+			{"created_at", raw.CreatedAt},
+			{"updated_at", raw.UpdatedAt},
+			{"closed_at", raw.ClosedAt},
+		}
+		for _, f := range fields {
+			if f.value == "" && f.name == "closed_at" {
+				continue
+			}
+			if format := issue.DetectDatetimeFormat(f.value); format != issue.FormatRFC3339 {
+				vs = add(vs, "dates-rfc3339", severityWarning, format != issue.FormatUnknown,
+					"%s is %s", f.name, format)
+			}
+		}
+	}
+
+	return vs
+}
+
+// hasFixable reports whether any violation can be fixed automatically
+func hasFixable(vs []violation) bool {
+	for _, v := range vs {
+		if v.Fixable {
+			return true
+		}
+	}
+	return false
+}
+
+// fixIssueFile lowercases labels and rewrites dates as RFC3339 UTC.
+// Missing or date-only timestamps are taken from git history when available,
+// like fix-datetime-format, then from the file's modification time.
+func fixIssueFile(path string) error {
+	iss, err := issue.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	raw, err := issue.GetRawDatetimeInfo(path)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	var labels []string
+	for _, label := range iss.Labels {
+		label = strings.ToLower(label)
+		if !seen[label] {
+			seen[label] = true
+			labels = append(labels, label)
+		}
+	}
+	iss.Labels = labels
+
+	if iss.CreatedAt.IsZero() || isDateOnlyFormat(issue.DetectDatetimeFormat(raw.CreatedAt)) {
+		if t := getGitCreatedTime(path); !t.IsZero() {
+			iss.CreatedAt = t
+		}
+	}
+	if iss.UpdatedAt.IsZero() || isDateOnlyFormat(issue.DetectDatetimeFormat(raw.UpdatedAt)) {
+		if t := getGitModifiedTime(path); !t.IsZero() {
+			iss.UpdatedAt = t
+		}
+	}
+
+	// Without git history, fall back to the file's modification time
+	if iss.CreatedAt.IsZero() || iss.UpdatedAt.IsZero() {
+		if info, err := os.Stat(path); err == nil {
+			if iss.CreatedAt.IsZero() {
+				iss.CreatedAt = info.ModTime().UTC()
+			}
+			if iss.UpdatedAt.IsZero() {
+				iss.UpdatedAt = info.ModTime().UTC()
+			}
+		}
+	}
+
+	data, err := issue.Serialize(iss)
+	if err != nil {
+		return fmt.Errorf("failed to serialize: %w", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
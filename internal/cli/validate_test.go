@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestValidateFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    []string // rule names
+	}{
+		{
+			name:    "valid",
+			file:    "001-ok.md",
+			content: "---\nnumber: 1\ntitle: OK\nstate: open\nlabels: [bug]\ncreated_at: 2026-01-17T06:30:00Z\nupdated_at: 2026-01-17T06:30:00Z\n---\n",
+		},
+		{
+			name:    "parse failure",
+			file:    "002-broken.md",
+			content: "---\nnumber: [\n---\n",
+			want:    []string{"parse"},
+		},
+		{
+			name:    "schema errors",
+			file:    "003-bad.md",
+			content: "---\nnumber: 4\ntitle: \"\"\nstate: todo\ncreated_at: 2026-01-17T06:30:00Z\nupdated_at: 2026-01-17T06:30:00Z\n---\n",
+			want:    []string{"number-filename", "state-valid", "title-required"},
+		},
+		{
+			name:    "fixable warnings",
+			file:    "005-warn.md",
+			content: "---\nnumber: 5\ntitle: Warn\nstate: done\nlabels: [Bug, ui]\ncreated_at: 2026-01-17\nupdated_at: 2026-01-17 15:47\nclosed_at: 2026-01-18T10:00:00Z\n---\n",
+			want:    []string{"dates-rfc3339", "dates-rfc3339", "labels-lowercase"},
+		},
+		{
+			name:    "unusable field value",
+			file:    "006-links.md",
+			content: "---\nnumber: 6\ntitle: Links\nstate: open\nblocks: [a]\ncreated_at: 2026-01-17T06:30:00Z\nupdated_at: 2026-01-17T06:30:00Z\n---\n",
+			want:    []string{"field-value"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, v := range validateFile(path) {
+				got = append(got, v.Rule)
+			}
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("validateFile() rules = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixIssueFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "001-fix.md")
+	content := "---\nnumber: 1\ntitle: Fix\nstate: open\nlabels: [Bug, bug, UI]\ncreated_at: 2026-01-17 15:47\nupdated_at: 2026-01-17T15:47:00\npriority: high\n---\n\nBody\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fixIssueFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	if vs := validateFile(path); len(vs) != 0 {
+		t.Errorf("violations after fix: %+v", vs)
+	}
+
+	data, _ := os.ReadFile(path)
+	for _, want := range []string{"- bug\n    - ui\n", "created_at: \"2026-01-17T15:47:00Z\"", "priority: high", "Body"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("fixed file missing %q:\n%s", want, data)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -12,9 +13,10 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/fsnotify/fsnotify"
 	"github.com/itda-work/zap/internal/ai"
+	"github.com/itda-work/zap/internal/config"
 	"github.com/itda-work/zap/internal/issue"
+	"github.com/itda-work/zap/internal/notify"
 	"github.com/itda-work/zap/internal/project"
 	"github.com/spf13/cobra"
 )
@@ -22,8 +24,11 @@ import (
 var watchCmd = &cobra.Command{
 	Use:   "watch",
 	Short: "Watch issues in real-time",
-	Long:  `Watch issues from the .issues directory in real-time. Updates automatically when files change.`,
-	RunE:  runWatch,
+	Long: `Watch issues from the .issues directory in real-time. Updates automatically when files change.
+
+In a terminal, single keys control the board: p pauses and resumes
+automatic refresh, r refreshes now and q quits.`,
+	RunE: runWatch,
 }
 
 const (
@@ -31,31 +36,67 @@ const (
 	DefaultWatchChangeMinutes = 10
 	// EnvWatchChangeMinutes is the environment variable for configuring change summary duration
 	EnvWatchChangeMinutes = "ZAP_WATCH_CHANGE_MINUTES"
+	// EnvWatchDebounceMS overrides watch_debounce_ms in .zap.yml
+	EnvWatchDebounceMS = "ZAP_WATCH_DEBOUNCE_MS"
 )
 
 var (
-	watchAll      bool
-	watchState    string
-	watchLabel    string
-	watchAssignee string
-	watchNoDate   bool
-	watchDuration int
-	watchAI       bool
+	watchAll        bool
+	watchState      string
+	watchLabels     []string
+	watchAssignees  []string
+	watchUnassigned bool
+	watchExcludeLbl []string
+	watchExcludeAsg []string
+	watchMatch      string
+	watchFilter     issue.Filter
+	watchNoDate     bool
+	watchDuration   int
+	watchAI         bool
+	watchAIModel    string
+	watchFor        time.Duration
+	watchNotify     bool
+	watchVerbose    bool
 )
 
+// watchBodyDiffLines is how many changed body lines --verbose shows per issue.
+const watchBodyDiffLines = 4
+
 func init() {
 	rootCmd.AddCommand(watchCmd)
 
 	watchCmd.Flags().BoolVarP(&watchAll, "all", "a", false, "Show all issues including done and closed")
-	watchCmd.Flags().StringVarP(&watchState, "state", "s", "", "Filter by state (open, wip, done, closed)")
-	watchCmd.Flags().StringVarP(&watchLabel, "label", "l", "", "Filter by label")
-	watchCmd.Flags().StringVar(&watchAssignee, "assignee", "", "Filter by assignee")
+	watchCmd.Flags().StringVarP(&watchState, "state", "s", "", "Filter by state ("+issue.ValidStates()+")")
+	watchCmd.Flags().StringSliceVarP(&watchLabels, "label", "l", nil, "Filter by label (comma-separated or repeated)")
+	watchCmd.Flags().StringSliceVar(&watchAssignees, "assignee", nil, "Filter by assignee (@me for your git user; comma-separated or repeated)")
+	watchCmd.Flags().BoolVar(&watchUnassigned, "unassigned", false, "Show only issues without assignees")
+	watchCmd.Flags().StringSliceVar(&watchExcludeLbl, "exclude-label", nil, "Hide issues with this label (comma-separated or repeated)")
+	watchCmd.Flags().StringSliceVar(&watchExcludeAsg, "exclude-assignee", nil, "Hide issues assigned to this person (comma-separated or repeated)")
+	watchCmd.Flags().StringVar(&watchMatch, "match", "any", "With several labels/assignees: any or all must match")
+	_ = watchCmd.RegisterFlagCompletionFunc("label", completeLabels)
+	_ = watchCmd.RegisterFlagCompletionFunc("assignee", completeAssignees)
 	watchCmd.Flags().BoolVar(&watchNoDate, "no-date", false, "Hide updated time from output")
 	watchCmd.Flags().IntVar(&watchDuration, "duration", 0, "Duration in minutes to show change summaries (default: 10, 0=disabled)")
 	watchCmd.Flags().BoolVar(&watchAI, "ai", false, "Enable AI-powered change summaries (claude → gemini fallback)")
+	watchCmd.Flags().StringVar(&watchAIModel, "ai-model", "", "AI model for change summaries (default: haiku/flash)")
+	watchCmd.Flags().DurationVar(&watchFor, "for", 0, "Exit after this duration (e.g., 30s, 5m; 0=until Ctrl+C)")
+	watchCmd.Flags().BoolVarP(&watchVerbose, "verbose", "v", false, "Show the changed body lines under each change summary")
+	watchCmd.Flags().BoolVar(&watchNotify, "notify", false, "Send desktop notifications for new issues and issues marked done (throttled)")
 }
 
 func runWatch(cmd *cobra.Command, args []string) error {
+	if watchFor < 0 {
+		return fmt.Errorf("--for must not be negative")
+	}
+
+	var err error
+	if watchFilter, err = buildIssueFilter(watchLabels, watchAssignees, watchMatch, watchUnassigned); err != nil {
+		return err
+	}
+	if watchFilter, err = addIssueExclusions(watchFilter, watchExcludeLbl, watchExcludeAsg); err != nil {
+		return err
+	}
+
 	if isMultiProjectMode(cmd) {
 		return runMultiProjectWatch(cmd, args)
 	}
@@ -65,14 +106,12 @@ func runWatch(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		return fmt.Errorf("failed to create watcher: %w", err)
-	}
-	defer watcher.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	if err := watcher.Add(dir); err != nil {
-		return fmt.Errorf("failed to watch directory: %w", err)
+	events, err := watchStores(ctx, []*issue.Store{issue.NewStore(dir)})
+	if err != nil {
+		return err
 	}
 
 	var tracker *changeTracker
@@ -91,15 +130,24 @@ func runWatch(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	var notifier *watchNotifier
+	if watchNotify {
+		notifier = newWatchNotifier()
+		if initIssues, err := issue.NewStore(dir).List(issue.AllStates()...); err == nil {
+			notifier.snapshot(initIssues)
+		}
+	}
+
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
 	winchChan := newWinchChan()
 
-	renderWatch(dir, tracker)
+	keys, restoreKeys := startWatchKeys()
+	defer restoreKeys()
+	paused := false
 
-	var debounceTimer *time.Timer
-	debounceDuration := 100 * time.Millisecond
+	renderWatch(dir, tracker)
 
 	ticker := time.NewTicker(1 * time.Minute)
 	defer ticker.Stop()
@@ -109,6 +157,8 @@ func runWatch(cmd *cobra.Command, args []string) error {
 		aiNotify = tracker.renderNotify
 	}
 
+	deadline := exitAfter(watchFor)
+
 	for {
 		select {
 		case <-sigChan:
@@ -116,44 +166,57 @@ func runWatch(cmd *cobra.Command, args []string) error {
 			fmt.Println("Watch mode exited.")
 			return nil
 
-		case <-winchChan:
-			renderWatch(dir, tracker)
-
-		case <-ticker.C:
-			renderWatch(dir, tracker)
-
-		case <-aiNotify:
-			renderWatch(dir, tracker)
+		case <-deadline:
+			fmt.Print("\033[H\033[2J")
+			fmt.Println("Watch mode exited.")
+			return nil
 
-		case event, ok := <-watcher.Events:
-			if !ok {
+		case key := <-keys:
+			switch watchKeyAction(key) {
+			case watchQuit:
+				fmt.Print("\033[H\033[2J")
+				fmt.Println("Watch mode exited.")
 				return nil
+			case watchTogglePause:
+				if paused = !paused; paused {
+					printWatchPaused()
+				} else {
+					renderWatch(dir, tracker)
+				}
+			case watchRefresh:
+				renderWatch(dir, tracker)
+				if paused {
+					printWatchPaused()
+				}
 			}
 
-			if !strings.HasSuffix(event.Name, ".md") {
-				continue
+		case <-winchChan:
+			if !paused {
+				renderWatch(dir, tracker)
 			}
 
-			if tracker != nil {
-				if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
-					tracker.processRemoval(event.Name)
-				} else {
-					tracker.processChange(event.Name)
-				}
+		case <-ticker.C:
+			if !paused {
+				renderWatch(dir, tracker)
 			}
 
-			if debounceTimer != nil {
-				debounceTimer.Stop()
-			}
-			debounceTimer = time.AfterFunc(debounceDuration, func() {
+		case <-aiNotify:
+			if !paused {
 				renderWatch(dir, tracker)
-			})
+			}
 
-		case err, ok := <-watcher.Errors:
+		case event, ok := <-events:
 			if !ok {
 				return nil
 			}
-			fmt.Fprintf(os.Stderr, "Watch error: %v\n", err)
+
+			render, notices := handleWatchEvents(event, events, tracker, notifier)
+			if render && !paused {
+				renderWatch(dir, tracker)
+			}
+			for _, notice := range notices {
+				fmt.Fprintln(os.Stderr, notice)
+			}
 		}
 	}
 }
@@ -164,17 +227,16 @@ func runMultiProjectWatch(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		return fmt.Errorf("failed to create watcher: %w", err)
-	}
-	defer watcher.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
+	var stores []*issue.Store
 	for _, proj := range multiStore.Projects() {
-		dir := proj.Store.BaseDir()
-		if err := watcher.Add(dir); err != nil {
-			return fmt.Errorf("failed to watch directory %s: %w", dir, err)
-		}
+		stores = append(stores, proj.Store)
+	}
+	events, err := watchStores(ctx, stores)
+	if err != nil {
+		return err
 	}
 
 	var tracker *changeTracker
@@ -196,15 +258,28 @@ func runMultiProjectWatch(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	var notifier *watchNotifier
+	if watchNotify {
+		notifier = newWatchNotifier()
+		if allPIssues, err := multiStore.ListAll(issue.AllStates()...); err == nil {
+			initIssues := make([]*issue.Issue, len(allPIssues))
+			for i, pi := range allPIssues {
+				initIssues[i] = pi.Issue
+			}
+			notifier.snapshot(initIssues)
+		}
+	}
+
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
 	winchChan := newWinchChan()
 
-	renderMultiProjectWatch(multiStore, tracker)
+	keys, restoreKeys := startWatchKeys()
+	defer restoreKeys()
+	paused := false
 
-	var debounceTimer *time.Timer
-	debounceDuration := 100 * time.Millisecond
+	renderMultiProjectWatch(multiStore, tracker)
 
 	ticker := time.NewTicker(1 * time.Minute)
 	defer ticker.Stop()
@@ -214,6 +289,8 @@ func runMultiProjectWatch(cmd *cobra.Command, args []string) error {
 		aiNotify = tracker.renderNotify
 	}
 
+	deadline := exitAfter(watchFor)
+
 	for {
 		select {
 		case <-sigChan:
@@ -221,44 +298,57 @@ func runMultiProjectWatch(cmd *cobra.Command, args []string) error {
 			fmt.Println("Watch mode exited.")
 			return nil
 
-		case <-winchChan:
-			renderMultiProjectWatch(multiStore, tracker)
-
-		case <-ticker.C:
-			renderMultiProjectWatch(multiStore, tracker)
-
-		case <-aiNotify:
-			renderMultiProjectWatch(multiStore, tracker)
+		case <-deadline:
+			fmt.Print("\033[H\033[2J")
+			fmt.Println("Watch mode exited.")
+			return nil
 
-		case event, ok := <-watcher.Events:
-			if !ok {
+		case key := <-keys:
+			switch watchKeyAction(key) {
+			case watchQuit:
+				fmt.Print("\033[H\033[2J")
+				fmt.Println("Watch mode exited.")
 				return nil
+			case watchTogglePause:
+				if paused = !paused; paused {
+					printWatchPaused()
+				} else {
+					renderMultiProjectWatch(multiStore, tracker)
+				}
+			case watchRefresh:
+				renderMultiProjectWatch(multiStore, tracker)
+				if paused {
+					printWatchPaused()
+				}
 			}
 
-			if !strings.HasSuffix(event.Name, ".md") {
-				continue
+		case <-winchChan:
+			if !paused {
+				renderMultiProjectWatch(multiStore, tracker)
 			}
 
-			if tracker != nil {
-				if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
-					tracker.processRemoval(event.Name)
-				} else {
-					tracker.processChange(event.Name)
-				}
+		case <-ticker.C:
+			if !paused {
+				renderMultiProjectWatch(multiStore, tracker)
 			}
 
-			if debounceTimer != nil {
-				debounceTimer.Stop()
-			}
-			debounceTimer = time.AfterFunc(debounceDuration, func() {
+		case <-aiNotify:
+			if !paused {
 				renderMultiProjectWatch(multiStore, tracker)
-			})
+			}
 
-		case err, ok := <-watcher.Errors:
+		case event, ok := <-events:
 			if !ok {
 				return nil
 			}
-			fmt.Fprintf(os.Stderr, "Watch error: %v\n", err)
+
+			render, notices := handleWatchEvents(event, events, tracker, notifier)
+			if render && !paused {
+				renderMultiProjectWatch(multiStore, tracker)
+			}
+			for _, notice := range notices {
+				fmt.Fprintln(os.Stderr, notice)
+			}
 		}
 	}
 }
@@ -268,7 +358,7 @@ func renderMultiProjectWatch(multiStore *project.MultiStore, tracker *changeTrac
 
 	fmt.Println(colorize("Issue Monitor", colorCyan) + " " +
 		colorize(fmt.Sprintf("(%d projects)", multiStore.ProjectCount()), colorGray) + " " +
-		colorize("(Press Ctrl+C to exit)", colorGray))
+		colorize(watchHint(), colorGray))
 	fmt.Println(strings.Repeat("─", 60))
 
 	allProjectIssues, err := multiStore.ListAll(issue.AllStates()...)
@@ -299,19 +389,12 @@ func renderMultiProjectWatch(multiStore *project.MultiStore, tracker *changeTrac
 		states = issue.ActiveStates()
 	}
 
-	var projectIssues []*project.ProjectIssue
-	if watchLabel != "" {
-		projectIssues, err = multiStore.FilterByLabel(watchLabel, states...)
-	} else if watchAssignee != "" {
-		projectIssues, err = multiStore.FilterByAssignee(watchAssignee, states...)
-	} else {
-		projectIssues, err = multiStore.ListAll(states...)
-	}
-
+	projectIssues, err := multiStore.ListAll(states...)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return
 	}
+	projectIssues = filterProjectIssues(projectIssues, watchFilter)
 
 	if len(projectIssues) == 0 {
 		fmt.Println(colorize("No active issues.", colorGray))
@@ -325,17 +408,6 @@ func renderMultiProjectWatch(multiStore *project.MultiStore, tracker *changeTrac
 }
 
 func printMultiProjectWatchIssueList(issues []*project.ProjectIssue, tracker *changeTracker) {
-	stateStyle := map[issue.State]struct {
-		tag        string
-		color      string
-		titleColor string
-	}{
-		issue.StateOpen:   {"[open]", "", ""},
-		issue.StateWip:    {"[wip]", colorBrightYellow, colorBrightYellow},
-		issue.StateDone:   {"[done]", colorBrightGreen, colorBrightGreen},
-		issue.StateClosed: {"[closed]", colorGray, colorLightGray},
-	}
-
 	var activeChanges map[string]*changeEntry
 	if tracker != nil {
 		activeChanges = tracker.getActiveChanges()
@@ -344,7 +416,7 @@ func printMultiProjectWatchIssueList(issues []*project.ProjectIssue, tracker *ch
 	termWidth := getTerminalWidth()
 
 	for _, pIss := range issues {
-		style := stateStyle[pIss.State]
+		style := stateStyle(pIss.State)
 		labels := ""
 		if len(pIss.Labels) > 0 {
 			labels = fmt.Sprintf(" [%s]", strings.Join(pIss.Labels, ", "))
@@ -371,6 +443,7 @@ func printMultiProjectWatchIssueList(issues []*project.ProjectIssue, tracker *ch
 				aiLine := fmt.Sprintf("                      %s %s", colorize("↳", colorCyan), colorize(entry.aiSummary, colorMagenta))
 				fmt.Println(truncateLine(aiLine, termWidth))
 			}
+			printBodyDiff(entry.bodyDiff, "                        ", termWidth)
 		}
 	}
 
@@ -380,7 +453,7 @@ func printMultiProjectWatchIssueList(issues []*project.ProjectIssue, tracker *ch
 func renderWatch(dir string, tracker *changeTracker) {
 	fmt.Print("\033[H\033[2J")
 
-	fmt.Println(colorize("Issue Monitor", colorCyan) + " " + colorize("(Press Ctrl+C to exit)", colorGray))
+	fmt.Println(colorize("Issue Monitor", colorCyan) + " " + colorize(watchHint(), colorGray))
 	fmt.Println(strings.Repeat("─", 60))
 
 	store := issue.NewStore(dir)
@@ -410,23 +483,16 @@ func renderWatch(dir string, tracker *changeTracker) {
 		states = issue.ActiveStates()
 	}
 
-	var issues []*issue.Issue
-	if watchLabel != "" {
-		issues, err = store.FilterByLabel(watchLabel, states...)
-	} else if watchAssignee != "" {
-		issues, err = store.FilterByAssignee(watchAssignee, states...)
-	} else {
-		issues, err = store.List(states...)
-	}
-
+	issues, err := store.List(states...)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return
 	}
+	issues = watchFilter.Apply(issues)
 
 	recentClosedDuration := getRecentClosedDuration()
 	if !watchAll && watchState == "" && recentClosedDuration > 0 {
-		recentIssues, err := getRecentlyClosedIssuesForWatch(store, recentClosedDuration, watchLabel, watchAssignee)
+		recentIssues, err := getRecentlyClosedIssues(store, recentClosedDuration, watchFilter)
 		if err == nil && len(recentIssues) > 0 {
 			issues = mergeIssues(issues, recentIssues)
 		}
@@ -444,27 +510,24 @@ func renderWatch(dir string, tracker *changeTracker) {
 }
 
 func printWatchStats(stats *issue.Stats) {
-	parts := []string{
-		fmt.Sprintf("%s: %s", "Open", colorize(fmt.Sprintf("%d", stats.ByState[issue.StateOpen]), "")),
-		fmt.Sprintf("%s: %s", colorize("WIP", colorBrightYellow), colorize(fmt.Sprintf("%d", stats.ByState[issue.StateWip]), colorBrightYellow)),
-		fmt.Sprintf("%s: %s", colorize("Done", colorBrightGreen), colorize(fmt.Sprintf("%d", stats.ByState[issue.StateDone]), colorBrightGreen)),
-		fmt.Sprintf("%s: %s", colorize("Closed", colorGray), colorize(fmt.Sprintf("%d", stats.ByState[issue.StateClosed]), colorGray)),
+	var parts []string
+	for _, state := range issue.AllStates() {
+		color := stateStyle(state).color
+		parts = append(parts, fmt.Sprintf("%s: %s", colorize(stateTitle(state), color), colorize(fmt.Sprintf("%d", stats.ByState[state]), color)))
 	}
 	fmt.Println(strings.Join(parts, " | "))
 }
 
-func printWatchIssueList(issues []*issue.Issue, recentClosedDuration time.Duration, tracker *changeTracker) {
-	stateStyle := map[issue.State]struct {
-		tag        string
-		color      string
-		titleColor string
-	}{
-		issue.StateOpen:   {"[open]", "", ""},
-		issue.StateWip:    {"[wip]", colorBrightYellow, colorBrightYellow},
-		issue.StateDone:   {"[done]", colorBrightGreen, colorBrightGreen},
-		issue.StateClosed: {"[closed]", colorGray, colorLightGray},
+// stateTitle is the name of a state in the watch header, e.g. "WIP".
+func stateTitle(state issue.State) string {
+	if state == issue.StateWip {
+		return "WIP"
 	}
+	name := string(state)
+	return strings.ToUpper(name[:1]) + name[1:]
+}
 
+func printWatchIssueList(issues []*issue.Issue, recentClosedDuration time.Duration, tracker *changeTracker) {
 	var activeChanges map[string]*changeEntry
 	if tracker != nil {
 		activeChanges = tracker.getActiveChanges()
@@ -473,7 +536,7 @@ func printWatchIssueList(issues []*issue.Issue, recentClosedDuration time.Durati
 	termWidth := getTerminalWidth()
 
 	for _, iss := range issues {
-		style := stateStyle[iss.State]
+		style := stateStyle(iss.State)
 		labels := ""
 		if len(iss.Labels) > 0 {
 			labels = fmt.Sprintf(" [%s]", strings.Join(iss.Labels, ", "))
@@ -517,6 +580,7 @@ func printWatchIssueList(issues []*issue.Issue, recentClosedDuration time.Durati
 				aiLine := fmt.Sprintf("         %s %s", colorize("↳", colorCyan), colorize(entry.aiSummary, colorMagenta))
 				fmt.Println(truncateLine(aiLine, termWidth))
 			}
+			printBodyDiff(entry.bodyDiff, "           ", termWidth)
 		}
 	}
 
@@ -530,6 +594,134 @@ type changeEntry struct {
 	summary     string
 	aiSummary   string
 	aiLoading   bool
+	bodyDiff    []string // Changed body lines, with --verbose
+}
+
+// printBodyDiff prints changed body lines below a change summary,
+// removed lines in red and added ones in green.
+func printBodyDiff(lines []string, indent string, termWidth int) {
+	for _, line := range lines {
+		color := colorGray
+		switch {
+		case strings.HasPrefix(line, "- "):
+			color = colorRed
+		case strings.HasPrefix(line, "+ "):
+			color = colorGreen
+		}
+		fmt.Println(truncateLine(indent+colorize(line, color), termWidth))
+	}
+}
+
+// watchNotifyInterval is the minimum time between desktop notifications.
+// Changes during the interval are combined into one notification.
+const watchNotifyInterval = 10 * time.Second
+
+// pendingNotification is a notification held back by throttling.
+type pendingNotification struct {
+	title   string
+	message string
+}
+
+// watchNotifier sends desktop notifications when a watched issue is created
+// or transitions to done, throttled so bursts of changes don't spam.
+type watchNotifier struct {
+	mu       sync.Mutex
+	states   map[notifyKey]issue.State // last seen state
+	interval time.Duration
+	last     time.Time
+	pending  []pendingNotification
+	timer    *time.Timer
+	send     func(title, message string) error
+}
+
+func newWatchNotifier() *watchNotifier {
+	return &watchNotifier{
+		states:   make(map[notifyKey]issue.State),
+		interval: watchNotifyInterval,
+		send:     notify.Send,
+	}
+}
+
+func (n *watchNotifier) snapshot(issues []*issue.Issue) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, iss := range issues {
+		n.states[newNotifyKey(iss)] = iss.State
+	}
+}
+
+// notifyKey identifies an issue by its number within its issues directory,
+// so a rename or a move between legacy state directories is not a new issue.
+type notifyKey struct {
+	dir    string
+	number int
+}
+
+func newNotifyKey(iss *issue.Issue) notifyKey {
+	dir := filepath.Dir(iss.FilePath)
+	if _, ok := issue.LookupState(issue.State(filepath.Base(dir))); ok {
+		dir = filepath.Dir(dir) // legacy {state}/ layout
+	}
+	return notifyKey{dir: dir, number: iss.Number}
+}
+
+// check notifies if a changed issue is new or just became done.
+func (n *watchNotifier) check(iss *issue.Issue) {
+	key := newNotifyKey(iss)
+	n.mu.Lock()
+	prev, known := n.states[key]
+	n.states[key] = iss.State
+	n.mu.Unlock()
+
+	message := fmt.Sprintf("#%d: %s", iss.Number, iss.Title)
+	switch {
+	case !known:
+		n.post("New Issue", message)
+	case prev != issue.StateDone && iss.State == issue.StateDone:
+		n.post("Issue Completed", message)
+	}
+}
+
+// post sends a notification now, or queues it until the throttle interval
+// has passed since the last one.
+func (n *watchNotifier) post(title, message string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	wait := n.interval - time.Since(n.last)
+	if wait <= 0 && n.timer == nil {
+		n.last = time.Now()
+		go n.send(title, message)
+		return
+	}
+
+	n.pending = append(n.pending, pendingNotification{title, message})
+	if n.timer == nil {
+		n.timer = time.AfterFunc(wait, n.flush)
+	}
+}
+
+// flush sends queued notifications as a single summary.
+func (n *watchNotifier) flush() {
+	n.mu.Lock()
+	pending := n.pending
+	n.pending = nil
+	n.timer = nil
+	n.last = time.Now()
+	n.mu.Unlock()
+
+	switch len(pending) {
+	case 0:
+		return
+	case 1:
+		_ = n.send(pending[0].title, pending[0].message)
+	default:
+		lines := make([]string, len(pending))
+		for i, p := range pending {
+			lines[i] = p.title + " " + p.message
+		}
+		_ = n.send(fmt.Sprintf("%d issue updates", len(pending)), strings.Join(lines, "\n"))
+	}
 }
 
 type changeTracker struct {
@@ -575,11 +767,8 @@ func (ct *changeTracker) takeSnapshot(issues []*issue.Issue) {
 	}
 }
 
-func (ct *changeTracker) processChange(filePath string) {
-	newIssue, err := issue.Parse(filePath)
-	if err != nil {
-		return
-	}
+func (ct *changeTracker) processChange(newIssue *issue.Issue) {
+	filePath := newIssue.FilePath
 
 	ct.mu.Lock()
 
@@ -611,6 +800,9 @@ func (ct *changeTracker) processChange(filePath string) {
 			summary:     summary,
 			aiLoading:   ct.aiClient != nil,
 		}
+		if watchVerbose && old.Body != newIssue.Body {
+			entry.bodyDiff = changedBodyLines(old.Body, newIssue.Body, watchBodyDiffLines)
+		}
 		ct.changes[filePath] = entry
 		oldCopy := *old
 		ct.snapshots[filePath] = newIssue
@@ -634,10 +826,12 @@ func (ct *changeTracker) fetchAISummary(filePath string, old, new *issue.Issue)
 	req := &ai.Request{
 		Prompt: prompt,
 	}
-	switch ct.aiClient.Name() {
-	case "gemini":
+	switch {
+	case watchAIModel != "":
+		req.Model = watchAIModel
+	case ct.aiClient.Name() == "gemini":
 		req.Model = "flash"
-	case "claude":
+	case ct.aiClient.Name() == "claude":
 		req.Model = "haiku"
 	}
 
@@ -793,45 +987,122 @@ func diffStringSlice(old, new []string) string {
 	return strings.Join(diffs, " ")
 }
 
-func getWatchChangeDuration() time.Duration {
-	if watchDuration > 0 {
-		return time.Duration(watchDuration) * time.Minute
+// watchStores merges the Store.Watch events of several stores into one
+// channel, which is closed once every store's watch has ended.
+func watchStores(ctx context.Context, stores []*issue.Store) (<-chan issue.Event, error) {
+	for _, store := range stores {
+		store.SetWatchDebounce(getWatchDebounce(store.BaseDir(), issue.DefaultWatchDebounce))
 	}
-	if val := os.Getenv(EnvWatchChangeMinutes); val != "" {
-		if minutes, err := strconv.Atoi(val); err == nil && minutes >= 0 {
-			if minutes == 0 {
-				return 0
-			}
-			return time.Duration(minutes) * time.Minute
+	if len(stores) == 1 {
+		return stores[0].Watch(ctx)
+	}
+
+	merged := make(chan issue.Event)
+	var wg sync.WaitGroup
+	for _, store := range stores {
+		events, err := store.Watch(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", store.BaseDir(), err)
 		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for event := range events {
+				select {
+				case merged <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
 	}
-	return DefaultWatchChangeMinutes * time.Minute
+	go func() {
+		wg.Wait()
+		close(merged)
+	}()
+	return merged, nil
 }
 
-func getRecentlyClosedIssuesForWatch(store *issue.Store, duration time.Duration, labelFilter, assigneeFilter string) ([]*issue.Issue, error) {
-	closedStates := []issue.State{issue.StateDone, issue.StateClosed}
+// handleWatchEvents applies first, and any further events already waiting
+// on events, to the tracker and notifier so a burst of changes is rendered
+// once. It reports whether to re-render and the notices to print after.
+func handleWatchEvents(first issue.Event, events <-chan issue.Event, tracker *changeTracker, notifier *watchNotifier) (bool, []string) {
+	var render bool
+	var notices []string
 
-	var issues []*issue.Issue
-	var err error
+	event := first
+	for {
+		switch event.Type {
+		case issue.EventDirRemoved:
+			notices = append(notices, fmt.Sprintf("%s was removed; waiting for it to reappear...", event.Path))
+		case issue.EventDirRestored:
+			render = true
+			notices = append(notices, fmt.Sprintf("Re-attached watch to %s", event.Path))
+		case issue.EventError:
+			notices = append(notices, fmt.Sprintf("Watch error: %v", event.Err))
+		case issue.EventRemoved:
+			render = true
+			if tracker != nil {
+				tracker.processRemoval(event.Path)
+			}
+		default:
+			render = true
+			if event.Issue != nil {
+				if tracker != nil {
+					tracker.processChange(event.Issue)
+				}
+				if notifier != nil {
+					notifier.check(event.Issue)
+				}
+			}
+		}
 
-	if labelFilter != "" {
-		issues, err = store.FilterByLabel(labelFilter, closedStates...)
-	} else if assigneeFilter != "" {
-		issues, err = store.FilterByAssignee(assigneeFilter, closedStates...)
-	} else {
-		issues, err = store.List(closedStates...)
+		var ok bool
+		select {
+		case event, ok = <-events:
+			if !ok {
+				return render, notices
+			}
+		default:
+			return render, notices
+		}
 	}
+}
 
-	if err != nil {
-		return nil, err
+// exitAfter returns a channel that fires once d has elapsed.
+// For d == 0 it returns nil, which blocks forever in a select.
+func exitAfter(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		return nil
 	}
+	return time.After(d)
+}
 
-	var recentIssues []*issue.Issue
-	for _, iss := range issues {
-		if isRecentlyClosed(iss.UpdatedAt, string(iss.State), duration) {
-			recentIssues = append(recentIssues, iss)
+// getWatchDebounce returns how long watch modes wait for changes in dir to
+// settle: ZAP_WATCH_DEBOUNCE_MS, then watch_debounce_ms in .zap.yml, then def.
+func getWatchDebounce(dir string, def time.Duration) time.Duration {
+	if val := os.Getenv(EnvWatchDebounceMS); val != "" {
+		if ms, err := strconv.Atoi(val); err == nil && ms > 0 {
+			return time.Duration(ms) * time.Millisecond
 		}
 	}
+	if cfg, err := config.Load(dir); err == nil && cfg.WatchDebounceMS > 0 {
+		return time.Duration(cfg.WatchDebounceMS) * time.Millisecond
+	}
+	return def
+}
 
-	return recentIssues, nil
+func getWatchChangeDuration() time.Duration {
+	if watchDuration > 0 {
+		return time.Duration(watchDuration) * time.Minute
+	}
+	if val := os.Getenv(EnvWatchChangeMinutes); val != "" {
+		if minutes, err := strconv.Atoi(val); err == nil && minutes >= 0 {
+			if minutes == 0 {
+				return 0
+			}
+			return time.Duration(minutes) * time.Minute
+		}
+	}
+	return DefaultWatchChangeMinutes * time.Minute
 }
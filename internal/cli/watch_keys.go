@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+)
+
+// watchAction is what a key pressed during watch mode does.
+type watchAction int
+
+const (
+	watchNoAction watchAction = iota
+	watchQuit
+	watchTogglePause
+	watchRefresh
+)
+
+// watchKeyAction maps a key to its watch action: p pauses or resumes auto
+// refresh, r refreshes now and q quits.
+func watchKeyAction(key byte) watchAction {
+	switch key {
+	case 'q', 'Q':
+		return watchQuit
+	case 'p', 'P':
+		return watchTogglePause
+	case 'r', 'R':
+		return watchRefresh
+	}
+	return watchNoAction
+}
+
+// watchKeysEnabled is set while watch mode reads single keys from stdin.
+var watchKeysEnabled bool
+
+// watchHint is the key help shown in the watch header.
+func watchHint() string {
+	if watchKeysEnabled {
+		return "(p: pause, r: refresh, q: quit)"
+	}
+	return "(Press Ctrl+C to exit)"
+}
+
+// printWatchPaused marks the board as paused below its last render.
+func printWatchPaused() {
+	fmt.Println(colorize("Paused: press p to resume, r to refresh", colorYellow))
+}
+
+// readKeys sends every byte read from f until it fails.
+func readKeys(f *os.File) <-chan byte {
+	keys := make(chan byte)
+	go func() {
+		buf := make([]byte, 1)
+		for {
+			n, err := f.Read(buf)
+			if err != nil {
+				return
+			}
+			if n == 1 {
+				keys <- buf[0]
+			}
+		}
+	}()
+	return keys
+}
@@ -0,0 +1,9 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris && !zos
+
+package cli
+
+// startWatchKeys is not supported on this platform; watch mode only
+// responds to Ctrl+C.
+func startWatchKeys() (keys <-chan byte, restore func()) {
+	return nil, func() {}
+}
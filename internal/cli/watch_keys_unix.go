@@ -0,0 +1,39 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos
+
+package cli
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+	"golang.org/x/term"
+)
+
+// startWatchKeys puts a terminal stdin in cbreak mode, so single keys are
+// read without Enter or echo, and returns them. Unlike raw mode, output
+// processing and Ctrl+C keep working. restore puts the terminal back and
+// must be called before exiting. Without a terminal, keys is nil.
+func startWatchKeys() (keys <-chan byte, restore func()) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, func() {}
+	}
+	old, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	if err != nil {
+		return nil, func() {}
+	}
+
+	cbreak := *old
+	cbreak.Lflag &^= unix.ICANON | unix.ECHO
+	cbreak.Cc[unix.VMIN] = 1
+	cbreak.Cc[unix.VTIME] = 0
+	if err := unix.IoctlSetTermios(fd, ioctlWriteTermios, &cbreak); err != nil {
+		return nil, func() {}
+	}
+
+	watchKeysEnabled = true
+	return readKeys(os.Stdin), func() {
+		watchKeysEnabled = false
+		_ = unix.IoctlSetTermios(fd, ioctlWriteTermios, old)
+	}
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/itda-work/zap/internal/config"
+	"github.com/itda-work/zap/internal/issue"
+)
+
+func TestWatchNotifier(t *testing.T) {
+	changed := func(path string, number int, state string) *issue.Issue {
+		return &issue.Issue{FilePath: path, Number: number, Title: "Test", State: issue.State(state)}
+	}
+
+	sent := make(chan string, 10)
+	n := newWatchNotifier()
+	n.interval = 50 * time.Millisecond
+	n.send = func(title, message string) error {
+		sent <- title
+		return nil
+	}
+
+	known := changed(".issues/001-known.md", 1, "open")
+	legacy := changed(".issues/open/004-legacy.md", 4, "open")
+	n.snapshot([]*issue.Issue{known, legacy})
+
+	// New issue: sent immediately.
+	n.check(changed(".issues/002-new.md", 2, "open"))
+	// Known issue becomes done, another new issue: throttled and combined.
+	n.check(changed(".issues/001-known.md", 1, "done"))
+	n.check(changed(".issues/003-other.md", 3, "open"))
+	// Unchanged state: no notification.
+	n.check(changed(".issues/001-known.md", 1, "done"))
+	// Renamed, or moved between legacy state directories: not new.
+	n.check(changed(".issues/001-renamed.md", 1, "done"))
+	n.check(changed(".issues/wip/004-legacy.md", 4, "wip"))
+	// The same number in another project is a different issue.
+	n.check(changed("other/.issues/001-known.md", 1, "open"))
+
+	want := []string{"New Issue", "3 issue updates"}
+	deadline := time.After(5 * time.Second)
+	for i := range want {
+		select {
+		case got := <-sent:
+			if got != want[i] {
+				t.Errorf("sent[%d] = %q, want %q", i, got, want[i])
+			}
+		case <-deadline:
+			t.Fatalf("timed out waiting for notification %q", want[i])
+		}
+	}
+	select {
+	case extra := <-sent:
+		t.Errorf("unexpected notification %q", extra)
+	default:
+	}
+}
+
+func TestChangeTrackerBodyDiff(t *testing.T) {
+	saved := watchVerbose
+	defer func() { watchVerbose = saved }()
+
+	old := &issue.Issue{Number: 1, FilePath: "001.md", State: issue.StateOpen, Body: "Steps\n1. Open"}
+	edited := *old
+	edited.Body = "Steps\n1. Open settings"
+
+	for _, verbose := range []bool{false, true} {
+		watchVerbose = verbose
+		tracker := newChangeTracker(time.Minute)
+		tracker.takeSnapshot([]*issue.Issue{old})
+		tracker.processChange(&edited)
+
+		entry := tracker.getActiveChanges()["001.md"]
+		if entry == nil || entry.summary != "body updated" {
+			t.Fatalf("verbose=%v: change entry = %+v", verbose, entry)
+		}
+		var want []string
+		if verbose {
+			want = []string{"- 1. Open", "+ 1. Open settings"}
+		}
+		if !slices.Equal(entry.bodyDiff, want) {
+			t.Errorf("verbose=%v: bodyDiff = %q, want %q", verbose, entry.bodyDiff, want)
+		}
+	}
+}
+
+func TestGetWatchDebounce(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, ".issues")
+	def := 100 * time.Millisecond
+
+	t.Setenv(EnvWatchDebounceMS, "")
+	if got := getWatchDebounce(dir, def); got != def {
+		t.Errorf("without config = %v, want %v", got, def)
+	}
+
+	if err := os.WriteFile(filepath.Join(root, config.FileName), []byte("watch_debounce_ms: 400\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := getWatchDebounce(dir, def); got != 400*time.Millisecond {
+		t.Errorf("from config = %v, want 400ms", got)
+	}
+
+	t.Setenv(EnvWatchDebounceMS, "750")
+	if got := getWatchDebounce(dir, def); got != 750*time.Millisecond {
+		t.Errorf("from env = %v, want 750ms", got)
+	}
+
+	t.Setenv(EnvWatchDebounceMS, "soon")
+	if got := getWatchDebounce(dir, def); got != 400*time.Millisecond {
+		t.Errorf("invalid env = %v, want the config value 400ms", got)
+	}
+}
+
+func TestWatchKeyAction(t *testing.T) {
+	tests := []struct {
+		key  byte
+		want watchAction
+	}{
+		{'q', watchQuit},
+		{'Q', watchQuit},
+		{'p', watchTogglePause},
+		{'r', watchRefresh},
+		{'x', watchNoAction},
+		{'\n', watchNoAction},
+	}
+
+	for _, tt := range tests {
+		if got := watchKeyAction(tt.key); got != tt.want {
+			t.Errorf("watchKeyAction(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+// Package config loads the per-project .zap.yml configuration.
+package config
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/itda-work/zap/internal/i18n"
+	"github.com/itda-work/zap/internal/issue"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the project config file.
+// It lives in the project root, next to the issues directory.
+const FileName = ".zap.yml"
+
+// Config holds project-level settings.
+type Config struct {
+	// States defines the issue states in display order (empty = open, wip,
+	// done, closed). The built-in states must be kept; more can be added.
+	States issue.StateSet `yaml:"states"`
+
+	// Workflow restricts state transitions (empty = all transitions allowed)
+	Workflow issue.Workflow `yaml:"workflow"`
+
+	// Labels maps label names to display colors (e.g., bug: red)
+	Labels map[string]string `yaml:"labels"`
+
+	// Lang selects the report and AI prompt language (en, ko; ZAP_LANG overrides)
+	Lang string `yaml:"lang"`
+
+	// UpdateCheck enables a once-a-day background check for new zap releases
+	UpdateCheck bool `yaml:"update_check"`
+
+	// Refs configures how commit messages reference issues (report, release-notes)
+	Refs RefsConfig `yaml:"refs"`
+
+	// WatchDebounceMS is how long watch modes wait for file changes to settle
+	// before re-rendering (0 = default; ZAP_WATCH_DEBOUNCE_MS overrides)
+	WatchDebounceMS int `yaml:"watch_debounce_ms"`
+}
+
+// RefsConfig configures issue references in commit messages.
+// #N is always recognized.
+type RefsConfig struct {
+	// Pattern is an extra regexp for references, with the issue number in
+	// the first capture group (e.g. ISSUE-(\d+))
+	Pattern string `yaml:"pattern"`
+
+	// Keywords mark an issue as resolved by a commit when they precede the
+	// reference (default: close, fix, resolve and their -s/-d forms)
+	Keywords []string `yaml:"keywords"`
+}
+
+// LabelColors lists the color names accepted in the labels section.
+// An ANSI 256-color number (0-255) is accepted as well.
+var LabelColors = []string{"red", "green", "yellow", "blue", "magenta", "cyan", "gray"}
+
+// Path returns the config file path for an issues directory.
+func Path(issuesDir string) string {
+	absDir, err := filepath.Abs(issuesDir)
+	if err != nil {
+		absDir = issuesDir
+	}
+	return filepath.Join(filepath.Dir(absDir), FileName)
+}
+
+// Load reads the config for an issues directory.
+// A missing config file is not an error; defaults are returned.
+func Load(issuesDir string) (*Config, error) {
+	cfg := &Config{}
+
+	path := Path(issuesDir)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// validate checks the state definitions and that all states referenced by
+// the config are known.
+func (c *Config) validate() error {
+	states := c.StateSet()
+	if err := states.Validate(); err != nil {
+		return fmt.Errorf("states: %w", err)
+	}
+	for _, d := range states {
+		if d.Color != "" && !isLabelColor(d.Color) {
+			return fmt.Errorf("states: invalid color %q for %q (valid: %s, or 0-255)",
+				d.Color, d.Name, strings.Join(LabelColors, ", "))
+		}
+	}
+
+	for from, targets := range c.Workflow.Transitions {
+		if _, ok := states.Parse(string(from)); !ok {
+			return fmt.Errorf("workflow: unknown state %q", from)
+		}
+		for _, to := range targets {
+			if _, ok := states.Parse(string(to)); !ok {
+				return fmt.Errorf("workflow: unknown state %q", to)
+			}
+		}
+	}
+
+	if c.Refs.Pattern != "" {
+		if _, err := issue.CompileRefPattern(c.Refs.Pattern); err != nil {
+			return fmt.Errorf("refs: %w", err)
+		}
+	}
+
+	if c.WatchDebounceMS < 0 {
+		return fmt.Errorf("watch_debounce_ms: must not be negative (got %d)", c.WatchDebounceMS)
+	}
+
+	if c.Lang != "" {
+		if _, ok := i18n.Parse(c.Lang); !ok {
+			return fmt.Errorf("lang: unsupported language %q (supported: en, ko)", c.Lang)
+		}
+	}
+	return nil
+}
+
+// ValidLabelColors returns the colors of the labels section without the
+// invalid ones, and a warning for each invalid color. Colors are cosmetic,
+// so Load does not fail on them.
+func (c *Config) ValidLabelColors() (map[string]string, []string) {
+	colors := make(map[string]string, len(c.Labels))
+	var warnings []string
+	for _, label := range slices.Sorted(maps.Keys(c.Labels)) {
+		color := c.Labels[label]
+		if !isLabelColor(color) {
+			warnings = append(warnings, fmt.Sprintf("labels: invalid color %q for %q ignored (valid: %s, or 0-255)",
+				color, label, strings.Join(LabelColors, ", ")))
+			continue
+		}
+		colors[label] = color
+	}
+	return colors, warnings
+}
+
+// isLabelColor reports whether color is a known color name or a 256-color number.
+func isLabelColor(color string) bool {
+	for _, name := range LabelColors {
+		if color == name {
+			return true
+		}
+	}
+	n, err := strconv.Atoi(color)
+	return err == nil && n >= 0 && n <= 255
+}
+
+// RefMatcher returns the matcher for issue references in commit messages.
+func (c *Config) RefMatcher() *issue.RefMatcher {
+	m, err := issue.NewRefMatcher(c.Refs.Pattern, c.Refs.Keywords)
+	if err != nil {
+		// validate rejects bad patterns, so this only happens for a
+		// hand-built Config
+		return issue.DefaultRefMatcher()
+	}
+	return m
+}
+
+// StateSet returns the configured states, or the built-in ones if the
+// config has no states section.
+func (c *Config) StateSet() issue.StateSet {
+	if len(c.States) == 0 {
+		return issue.DefaultStates()
+	}
+	return c.States
+}
+
+// WorkflowPolicy returns the workflow to enforce, or nil if none is configured.
+func (c *Config) WorkflowPolicy() *issue.Workflow {
+	if len(c.Workflow.Transitions) == 0 {
+		return nil
+	}
+	return &c.Workflow
+}
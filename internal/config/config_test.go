@@ -0,0 +1,168 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), ".issues"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.WorkflowPolicy() != nil {
+		t.Error("WorkflowPolicy should be nil without a config file")
+	}
+}
+
+func TestLoadWorkflow(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"valid", "workflow:\n  transitions:\n    open: [wip]\n    wip: [done, open]\n", false},
+		{"unknown state", "workflow:\n  transitions:\n    open: [review]\n", true},
+		{"invalid yaml", "workflow: [\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			if err := os.WriteFile(filepath.Join(root, FileName), []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := Load(filepath.Join(root, ".issues"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			wf := cfg.WorkflowPolicy()
+			if wf == nil {
+				t.Fatal("WorkflowPolicy should not be nil")
+			}
+			if err := wf.Check(issue.StateOpen, issue.StateDone); err == nil {
+				t.Error("open → done should be rejected")
+			}
+		})
+	}
+}
+
+func TestLoadStates(t *testing.T) {
+	builtins := "  - {name: open, active: true}\n  - {name: wip, active: true}\n  - {name: done, terminal: true}\n  - {name: closed, terminal: true}\n"
+	tests := []struct {
+		name    string
+		content string
+		want    int
+		wantErr bool
+	}{
+		{"default", "lang: en\n", 4, false},
+		{"extra state", "states:\n" + builtins + "  - {name: review, color: cyan, active: true}\n", 5, false},
+		{"workflow with extra state", "states:\n" + builtins + "  - {name: review, active: true}\nworkflow:\n  transitions:\n    wip: [review]\n", 5, false},
+		{"missing built-in", "states:\n  - {name: open, active: true}\n", 0, true},
+		{"invalid color", "states:\n" + builtins + "  - {name: review, color: pink}\n", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			if err := os.WriteFile(filepath.Join(root, FileName), []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := Load(filepath.Join(root, ".issues"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(cfg.StateSet()) != tt.want {
+				t.Errorf("StateSet() has %d states, want %d", len(cfg.StateSet()), tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadLabelColors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"named colors", "labels:\n  bug: red\n  feature: green\n", false},
+		{"256-color number", "labels:\n  docs: \"208\"\n", false},
+		{"unknown color", "labels:\n  bug: crimson\n", false},
+		{"out of range", "labels:\n  bug: \"300\"\n", false},
+		{"lang", "lang: en\n", false},
+		{"unsupported lang", "lang: fr\n", true},
+		{"watch debounce", "watch_debounce_ms: 500\n", false},
+		{"negative watch debounce", "watch_debounce_ms: -1\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			if err := os.WriteFile(filepath.Join(root, FileName), []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := Load(filepath.Join(root, ".issues"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Load error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidLabelColors(t *testing.T) {
+	cfg := &Config{Labels: map[string]string{"bug": "red", "docs": "208", "feature": "crimson", "old": "300"}}
+
+	colors, warnings := cfg.ValidLabelColors()
+	if len(colors) != 2 || colors["bug"] != "red" || colors["docs"] != "208" {
+		t.Errorf("colors = %v, want bug and docs only", colors)
+	}
+	if len(warnings) != 2 || !strings.Contains(warnings[0], `"feature"`) || !strings.Contains(warnings[1], `"old"`) {
+		t.Errorf("warnings = %q, want one each for feature and old", warnings)
+	}
+}
+
+func TestLoadRefs(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"prefix pattern", "refs:\n  pattern: 'ISSUE-(\\d+)'\n  keywords: [done]\n", false},
+		{"no capture group", "refs:\n  pattern: 'ISSUE-\\d+'\n", true},
+		{"bad regexp", "refs:\n  pattern: 'ISSUE-(\\d+'\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			if err := os.WriteFile(filepath.Join(root, FileName), []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := Load(filepath.Join(root, ".issues"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			refs := cfg.RefMatcher().Match("done ISSUE-4, fixes #2")
+			want := []issue.CommitRef{{Number: 4, Resolves: true}, {Number: 2, Resolves: false}}
+			if len(refs) != len(want) || refs[0] != want[0] || refs[1] != want[1] {
+				t.Errorf("Match() = %v, want %v", refs, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Value is a single config entry as shown by List.
+type Value struct {
+	Key   string // dotted path, e.g. "labels.bug"
+	Value string // scalar value, or flow-style YAML for sequences
+}
+
+// KnownKeys returns the top-level keys understood by Config, sorted.
+func KnownKeys() []string {
+	var keys []string
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		if name != "" && name != "-" {
+			keys = append(keys, name)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsKnownKey reports whether the top-level part of a dotted key is a
+// Config field.
+func IsKnownKey(key string) bool {
+	top, _, _ := strings.Cut(key, ".")
+	for _, k := range KnownKeys() {
+		if k == top {
+			return true
+		}
+	}
+	return false
+}
+
+// Get returns the value at a dotted key in the config file for an issues
+// directory. Mappings and sequences are returned as YAML.
+func Get(issuesDir, key string) (string, error) {
+	doc, err := loadDocument(Path(issuesDir))
+	if err != nil {
+		return "", err
+	}
+
+	node := doc.Content[0]
+	for _, part := range strings.Split(key, ".") {
+		if node.Kind != yaml.MappingNode {
+			return "", fmt.Errorf("%s is not set", key)
+		}
+		if node = mappingValue(node, part); node == nil {
+			return "", fmt.Errorf("%s is not set", key)
+		}
+	}
+
+	if node.Kind == yaml.ScalarNode {
+		return node.Value, nil
+	}
+	out, err := yaml.Marshal(node)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(out), "\n"), nil
+}
+
+// Set writes value at a dotted key in the config file for an issues
+// directory, creating the file and intermediate mappings as needed.
+// value is parsed as YAML, so "[wip, done]" sets a list.
+// Comments and key order of the existing file are kept. The result is
+// validated before it is written.
+func Set(issuesDir, key, value string) error {
+	path := Path(issuesDir)
+	doc, err := loadDocument(path)
+	if err != nil {
+		return err
+	}
+
+	var parsed yaml.Node
+	if err := yaml.Unmarshal([]byte(value), &parsed); err != nil {
+		return fmt.Errorf("invalid value %q: %w", value, err)
+	}
+	newValue := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null"}
+	if len(parsed.Content) > 0 {
+		newValue = parsed.Content[0]
+	}
+
+	parts := strings.Split(key, ".")
+	node := doc.Content[0]
+	for i, part := range parts {
+		if part == "" {
+			return fmt.Errorf("invalid key %q", key)
+		}
+		if node.Kind != yaml.MappingNode {
+			return fmt.Errorf("%s is not a mapping", strings.Join(parts[:i], "."))
+		}
+
+		existing := mappingValue(node, part)
+		if i == len(parts)-1 {
+			if existing != nil {
+				newValue.LineComment = existing.LineComment
+				*existing = *newValue
+			} else {
+				node.Content = append(node.Content,
+					&yaml.Node{Kind: yaml.ScalarNode, Value: part}, newValue)
+			}
+			break
+		}
+
+		if existing == nil {
+			existing = &yaml.Node{Kind: yaml.MappingNode}
+			node.Content = append(node.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: part}, existing)
+		}
+		node = existing
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	enc.Close()
+
+	var cfg Config
+	if err := yaml.Unmarshal(buf.Bytes(), &cfg); err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// List returns all leaf values in the config file for an issues directory,
+// in file order.
+func List(issuesDir string) ([]Value, error) {
+	doc, err := loadDocument(Path(issuesDir))
+	if err != nil {
+		return nil, err
+	}
+
+	var values []Value
+	var walk func(prefix string, node *yaml.Node) error
+	walk = func(prefix string, node *yaml.Node) error {
+		switch node.Kind {
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				key := node.Content[i].Value
+				if prefix != "" {
+					key = prefix + "." + key
+				}
+				if err := walk(key, node.Content[i+1]); err != nil {
+					return err
+				}
+			}
+		case yaml.ScalarNode:
+			values = append(values, Value{Key: prefix, Value: node.Value})
+		default:
+			flow := *node
+			flow.Style = yaml.FlowStyle
+			out, err := yaml.Marshal(&flow)
+			if err != nil {
+				return err
+			}
+			values = append(values, Value{Key: prefix, Value: strings.TrimRight(string(out), "\n")})
+		}
+		return nil
+	}
+
+	if err := walk("", doc.Content[0]); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// loadDocument reads the config file as a YAML node tree. A missing or
+// empty file yields an empty mapping.
+func loadDocument(path string) (*yaml.Node, error) {
+	empty := &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return empty, nil
+		}
+		return nil, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return empty, nil
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("failed to parse %s: top level must be a mapping", path)
+	}
+	return &doc, nil
+}
+
+// mappingValue returns the value node for key in a mapping node, or nil.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetGetList(t *testing.T) {
+	root := t.TempDir()
+	issuesDir := filepath.Join(root, ".issues")
+	content := "# project settings\nlang: ko # report language\nlabels:\n  bug: red\n"
+	if err := os.WriteFile(filepath.Join(root, FileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Set(issuesDir, "lang", "en"); err != nil {
+		t.Fatalf("Set lang failed: %v", err)
+	}
+	if err := Set(issuesDir, "workflow.transitions.open", "[wip, closed]"); err != nil {
+		t.Fatalf("Set workflow failed: %v", err)
+	}
+
+	if got, err := Get(issuesDir, "lang"); err != nil || got != "en" {
+		t.Errorf("Get(lang) = %q, %v; want en", got, err)
+	}
+	if _, err := Get(issuesDir, "labels.feature"); err == nil {
+		t.Error("Get of a missing key should fail")
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, FileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"# project settings", "# report language", "bug: red"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("config lost %q:\n%s", want, data)
+		}
+	}
+
+	cfg, err := Load(issuesDir)
+	if err != nil {
+		t.Fatalf("Load after Set failed: %v", err)
+	}
+	if policy := cfg.WorkflowPolicy(); policy == nil || len(policy.Transitions["open"]) != 2 {
+		t.Errorf("workflow not written: %+v", cfg.Workflow)
+	}
+
+	values, err := List(issuesDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, v := range values {
+		keys = append(keys, v.Key+"="+v.Value)
+	}
+	want := "lang=en labels.bug=red workflow.transitions.open=[wip, closed]"
+	if got := strings.Join(keys, " "); got != want {
+		t.Errorf("List() = %q, want %q", got, want)
+	}
+}
+
+func TestSetValidates(t *testing.T) {
+	root := t.TempDir()
+	issuesDir := filepath.Join(root, ".issues")
+
+	if err := Set(issuesDir, "lang", "xx"); err == nil {
+		t.Error("Set should reject an unsupported language")
+	}
+	if _, err := os.Stat(filepath.Join(root, FileName)); !os.IsNotExist(err) {
+		t.Error("invalid Set must not write the config file")
+	}
+	if err := Set(issuesDir, "lang.sub", "x"); err == nil {
+		t.Error("Set should fail when the parent is not a mapping")
+	}
+}
+
+func TestIsKnownKey(t *testing.T) {
+	for key, want := range map[string]bool{
+		"lang":       true,
+		"labels.bug": true,
+		"workflow":   true,
+		"theme":      false,
+	} {
+		if got := IsKnownKey(key); got != want {
+			t.Errorf("IsKnownKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Package i18n provides message catalogs for user-facing report text
+// and AI prompts.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// EnvLang is the environment variable that selects the output language.
+// It takes precedence over the lang setting in .zap.yml.
+const EnvLang = "ZAP_LANG"
+
+// Lang is a supported output language.
+type Lang string
+
+const (
+	English Lang = "en"
+	Korean  Lang = "ko"
+)
+
+// Default is the language used when nothing is configured.
+const Default = Korean
+
+// Langs returns all supported languages.
+func Langs() []Lang {
+	return []Lang{English, Korean}
+}
+
+// current is the active language (set via Set).
+var current = Default
+
+// Parse converts a language name to Lang.
+// Locale forms such as "en_US.UTF-8" or "ko-KR" are accepted.
+func Parse(s string) (Lang, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if i := strings.IndexAny(s, "_-."); i >= 0 {
+		s = s[:i]
+	}
+	switch Lang(s) {
+	case English, Korean:
+		return Lang(s), true
+	default:
+		return "", false
+	}
+}
+
+// Resolve picks the language from ZAP_LANG, then the configured value,
+// falling back to Default. Unknown values are ignored.
+func Resolve(configured string) Lang {
+	if l, ok := Parse(os.Getenv(EnvLang)); ok {
+		return l
+	}
+	if l, ok := Parse(configured); ok {
+		return l
+	}
+	return Default
+}
+
+// Set changes the active language.
+func Set(l Lang) {
+	current = l
+}
+
+// Current returns the active language.
+func Current() Lang {
+	return current
+}
+
+// T returns the message for key in the active language, formatted with args.
+// Messages missing from a catalog fall back to English, then to the key itself.
+func T(key string, args ...any) string {
+	msg, ok := catalogs[current][key]
+	if !ok {
+		msg, ok = catalogs[English][key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
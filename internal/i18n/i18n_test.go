@@ -0,0 +1,84 @@
+package i18n
+
+import (
+	"testing"
+)
+
+func TestCatalogsComplete(t *testing.T) {
+	for _, lang := range Langs() {
+		for key := range catalogs[English] {
+			if _, ok := catalogs[lang][key]; !ok {
+				t.Errorf("%s catalog is missing %q", lang, key)
+			}
+		}
+		for key := range catalogs[lang] {
+			if _, ok := catalogs[English][key]; !ok {
+				t.Errorf("%s catalog has %q which is not in English", lang, key)
+			}
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Lang
+		ok    bool
+	}{
+		{"en", English, true},
+		{"EN", English, true},
+		{"en_US.UTF-8", English, true},
+		{"ko-KR", Korean, true},
+		{"ko", Korean, true},
+		{"fr", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := Parse(tt.input)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Parse(%q) = (%q, %v), want (%q, %v)", tt.input, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        string
+		configured string
+		want       Lang
+	}{
+		{"default", "", "", Default},
+		{"config", "", "en", English},
+		{"env overrides config", "ko", "en", Korean},
+		{"invalid env ignored", "xx", "en", English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvLang, tt.env)
+			if got := Resolve(tt.configured); got != tt.want {
+				t.Errorf("Resolve(%q) = %q, want %q", tt.configured, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	defer Set(Current())
+
+	Set(English)
+	if got := T("report.commits", 3); got != "Commits (3)" {
+		t.Errorf("T() = %q", got)
+	}
+
+	Set(Korean)
+	if got := T("report.commits", 3); got != "커밋 (3건)" {
+		t.Errorf("T() = %q", got)
+	}
+
+	if got := T("no.such.key"); got != "no.such.key" {
+		t.Errorf("T() for unknown key = %q", got)
+	}
+}
@@ -0,0 +1,94 @@
+package i18n
+
+// catalogs maps each language to its messages. Every key must exist in
+// every catalog (checked by tests).
+var catalogs = map[Lang]map[string]string{
+	English: {
+		// Report
+		"report.title":           "Work Report",
+		"report.period":          "Period: %s",
+		"report.summary":         "Summary",
+		"report.commits":         "Commits (%d)",
+		"report.col.hash":        "Hash",
+		"report.col.message":     "Message",
+		"report.col.issues":      "Related issues",
+		"report.issues":          "Issue Progress",
+		"report.state.done":      "Done (done)",
+		"report.state.wip":       "In progress (wip)",
+		"report.state.open":      "New (open)",
+		"report.state.closed":    "Cancelled (closed)",
+		"report.no_commits":      "no linked commits",
+		"report.resolved_by":     "resolved by %s",
+		"report.files":           "File Changes",
+		"report.files.added":     "Added: %d files",
+		"report.files.modified":  "Modified: %d files",
+		"report.files.deleted":   "Deleted: %d files",
+		"report.files.main_area": "Main area: %s",
+		"report.files.summary":   "Added: %d, Modified: %d, Deleted: %d",
+		"report.compare":         "Compared with %s",
+		"report.compare.commits": "Commits: %d (previously %d, %s)",
+		"report.compare.closed":  "Issues closed: %d (previously %d, %s)",
+		"report.compare.files":   "Files changed: %d (previously %d, %s)",
+		"report.ai.commits":      "Commits",
+		"report.ai.issues":       "Issue status",
+		"report.ai.system": `You are a technical writer preparing a work report for a development team.
+Write a summary for sharing with the team based on the given commits and issues.
+
+Rules:
+- Write in English
+- Summarize the key outcomes in 2-3 sentences
+- Highlight the main changes
+- If a comparison with the previous period is given, mention notable trends
+- Keep a professional, concise tone
+- Output only the summary, without extra explanation or commentary`,
+		"report.ai.user": `Here is the work done during %s.
+
+%s
+
+Write a report summary for sharing with the team based on the above.`,
+	},
+	Korean: {
+		// Report
+		"report.title":           "작업 보고서",
+		"report.period":          "기간: %s",
+		"report.summary":         "요약",
+		"report.commits":         "커밋 (%d건)",
+		"report.col.hash":        "해시",
+		"report.col.message":     "메시지",
+		"report.col.issues":      "관련 이슈",
+		"report.issues":          "이슈 진행 상황",
+		"report.state.done":      "완료 (done)",
+		"report.state.wip":       "진행 중 (wip)",
+		"report.state.open":      "신규 (open)",
+		"report.state.closed":    "취소 (closed)",
+		"report.no_commits":      "연결된 커밋 없음",
+		"report.resolved_by":     "%s에서 해결",
+		"report.files":           "파일 변경 통계",
+		"report.files.added":     "추가: %d개 파일",
+		"report.files.modified":  "수정: %d개 파일",
+		"report.files.deleted":   "삭제: %d개 파일",
+		"report.files.main_area": "주요 변경 영역: %s",
+		"report.files.summary":   "추가: %d, 수정: %d, 삭제: %d",
+		"report.compare":         "%s 대비",
+		"report.compare.commits": "커밋: %d건 (이전 %d건, %s)",
+		"report.compare.closed":  "완료된 이슈: %d건 (이전 %d건, %s)",
+		"report.compare.files":   "변경된 파일: %d개 (이전 %d개, %s)",
+		"report.ai.commits":      "커밋 목록",
+		"report.ai.issues":       "이슈 상태",
+		"report.ai.system": `당신은 개발팀의 작업 보고서를 작성하는 테크니컬 라이터입니다.
+주어진 커밋과 이슈 정보를 바탕으로 팀 공유용 요약을 작성하세요.
+
+규칙:
+- 한국어로 작성
+- 2-3문장으로 핵심 성과 요약
+- 주요 변경 사항 강조
+- 이전 기간과의 비교가 주어지면 눈에 띄는 추세 언급
+- 전문적이고 간결한 톤 유지
+- 추가 설명이나 코멘트 없이 요약만 출력`,
+		"report.ai.user": `다음은 %s 동안의 작업 내역입니다.
+
+%s
+
+위 내용을 바탕으로 팀 공유용 보고서 요약을 작성해주세요.`,
+	},
+}
@@ -0,0 +1,51 @@
+package issue
+
+import (
+	"net/url"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Attachment is a file or URL related to an issue, listed under
+// attachments: in the frontmatter.
+type Attachment struct {
+	Title string `yaml:"title,omitempty"`
+	URL   string `yaml:"url"`
+}
+
+// UnmarshalYAML accepts a bare string as an attachment with only a URL,
+// besides the {title, url} mapping.
+func (a *Attachment) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		a.URL = node.Value
+		return nil
+	}
+	type plain Attachment
+	return node.Decode((*plain)(a))
+}
+
+// Label returns the attachment title, or its URL when it has none.
+func (a Attachment) Label() string {
+	if strings.TrimSpace(a.Title) != "" {
+		return a.Title
+	}
+	return a.URL
+}
+
+// HasValidURL loosely checks the attachment URL. Relative file paths are
+// accepted; web URLs must name a host.
+func (a Attachment) HasValidURL() bool {
+	raw := strings.TrimSpace(a.URL)
+	if raw == "" || strings.ContainsAny(raw, " \t\n") {
+		return false
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "http" || u.Scheme == "https" {
+		return u.Host != ""
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package issue
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseAttachments(t *testing.T) {
+	content := `---
+number: 1
+title: Test
+state: open
+attachments:
+  - title: Design doc
+    url: https://example.com/design
+  - docs/notes.md
+created_at: 2024-01-01T00:00:00Z
+updated_at: 2024-01-01T00:00:00Z
+---
+`
+	iss, err := ParseBytes([]byte(content), "001-test.md")
+	if err != nil {
+		t.Fatalf("ParseBytes() error = %v", err)
+	}
+	want := []Attachment{
+		{Title: "Design doc", URL: "https://example.com/design"},
+		{URL: "docs/notes.md"},
+	}
+	if len(iss.Attachments) != len(want) {
+		t.Fatalf("Attachments = %+v, want %+v", iss.Attachments, want)
+	}
+	for i := range want {
+		if iss.Attachments[i] != want[i] {
+			t.Errorf("Attachments[%d] = %+v, want %+v", i, iss.Attachments[i], want[i])
+		}
+	}
+	if _, ok := iss.Extra["attachments"]; ok {
+		t.Error("attachments should not be kept in Extra")
+	}
+
+	data, err := Serialize(iss)
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	if !strings.Contains(string(data), "attachments:\n    - title: Design doc\n      url: https://example.com/design\n    - url: docs/notes.md\n") {
+		t.Errorf("Serialize() attachments not written as expected:\n%s", data)
+	}
+
+	iss.Attachments = nil
+	data, err = Serialize(iss)
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	if strings.Contains(string(data), "attachments") {
+		t.Errorf("empty attachments should be omitted:\n%s", data)
+	}
+}
+
+func TestParseAttachmentsShapes(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		want      []Attachment
+		wantExtra bool
+	}{
+		{"single string", "https://example.com/x", []Attachment{{URL: "https://example.com/x"}}, false},
+		{"list of strings", "[a.md, b.md]", []Attachment{{URL: "a.md"}, {URL: "b.md"}}, false},
+		{"mapping", "{url: a.md}", nil, true},
+		{"nested list", "[[a.md]]", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "---\nnumber: 1\ntitle: Test\nstate: open\nattachments: " + tt.value + "\ncreated_at: 2024-01-01T00:00:00Z\nupdated_at: 2024-01-01T00:00:00Z\n---\n"
+			iss, err := ParseBytes([]byte(content), "001-test.md")
+			if err != nil {
+				t.Fatalf("ParseBytes() error = %v", err)
+			}
+			if len(iss.Attachments) != len(tt.want) {
+				t.Fatalf("Attachments = %+v, want %+v", iss.Attachments, tt.want)
+			}
+			for i := range tt.want {
+				if iss.Attachments[i] != tt.want[i] {
+					t.Errorf("Attachments[%d] = %+v, want %+v", i, iss.Attachments[i], tt.want[i])
+				}
+			}
+			_, inExtra := iss.Extra["attachments"]
+			if inExtra != tt.wantExtra || (len(iss.ParseWarnings) > 0) != tt.wantExtra {
+				t.Errorf("Extra = %v, warnings = %v, want kept: %v", iss.Extra, iss.ParseWarnings, tt.wantExtra)
+			}
+		})
+	}
+}
+
+func TestAttachmentHasValidURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://example.com/a", true},
+		{"mailto:someone@example.com", true},
+		{"docs/notes.md", true},
+		{"", false},
+		{"https://", false},
+		{"not a url", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			if got := (Attachment{URL: tt.url}).HasValidURL(); got != tt.want {
+				t.Errorf("HasValidURL(%q) = %v, want %v", tt.url, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package issue
+
+import (
+	"strings"
+	"time"
+)
+
+// CommentsHeading starts the section of the issue body comments are
+// appended to.
+const CommentsHeading = "## Comments"
+
+// AddComment appends a timestamped comment to the comments section of the
+// body, creating the section at the end of the body if it is missing. A
+// comment goes after the existing ones, before any section that follows.
+func (i *Issue) AddComment(text string, at time.Time) {
+	entry := "- " + at.UTC().Format("2006-01-02 15:04 UTC") + " " + strings.TrimSpace(text)
+
+	lines := strings.Split(strings.TrimRight(i.Body, "\n"), "\n")
+	heading := -1
+	for n, line := range lines {
+		if strings.TrimSpace(line) == CommentsHeading {
+			heading = n
+			break
+		}
+	}
+
+	if heading < 0 {
+		body := strings.TrimRight(i.Body, "\n")
+		if strings.TrimSpace(body) != "" {
+			body += "\n\n"
+		}
+		i.Body = body + CommentsHeading + "\n\n" + entry
+		return
+	}
+
+	// The section ends at the next heading of the same or a higher level
+	end := len(lines)
+	for n := heading + 1; n < len(lines); n++ {
+		if strings.HasPrefix(lines[n], "# ") || strings.HasPrefix(lines[n], "## ") {
+			end = n
+			break
+		}
+	}
+	// Keep blank lines between the last comment and the next section
+	last := end
+	for last > heading+1 && strings.TrimSpace(lines[last-1]) == "" {
+		last--
+	}
+
+	section := append([]string{}, lines[:last]...)
+	if last == heading+1 {
+		section = append(section, "")
+	}
+	section = append(section, entry)
+	if end < len(lines) {
+		section = append(section, "")
+	}
+	i.Body = strings.Join(append(section, lines[end:]...), "\n")
+}
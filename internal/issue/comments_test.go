@@ -0,0 +1,35 @@
+package issue
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddComment(t *testing.T) {
+	at := time.Date(2026, 3, 4, 5, 6, 0, 0, time.UTC)
+	entry := "- 2026-03-04 05:06 UTC wip → closed: duplicate of #3"
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty body", "", "## Comments\n\n" + entry},
+		{"no section", "Some text.\n", "Some text.\n\n## Comments\n\n" + entry},
+		{"empty section", "Text\n\n## Comments\n", "Text\n\n## Comments\n\n" + entry},
+		{"existing comments", "## Comments\n\n- earlier\n\n",
+			"## Comments\n\n- earlier\n" + entry},
+		{"section before another", "## Comments\n\n- earlier\n\n## Notes\n\nmore",
+			"## Comments\n\n- earlier\n" + entry + "\n\n## Notes\n\nmore"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss := &Issue{Body: tt.body}
+			iss.AddComment(" wip → closed: duplicate of #3 ", at)
+			if iss.Body != tt.want {
+				t.Errorf("AddComment() body = %q, want %q", iss.Body, tt.want)
+			}
+		})
+	}
+}
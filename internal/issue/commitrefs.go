@@ -0,0 +1,133 @@
+package issue
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultCloseKeywords are the words that, directly before a reference,
+// mark the issue as resolved by the commit ("fixes #12").
+var DefaultCloseKeywords = []string{
+	"close", "closes", "closed",
+	"fix", "fixes", "fixed",
+	"resolve", "resolves", "resolved",
+}
+
+// CommitRef is an issue reference found in a commit message.
+type CommitRef struct {
+	Number   int
+	Resolves bool // Preceded by a close keyword, e.g. "closes #3"
+}
+
+// RefMatcher finds issue references in commit messages. #N is always
+// recognized; an extra pattern can match project conventions such as
+// ISSUE-123.
+type RefMatcher struct {
+	extra    *regexp.Regexp // nil if not configured
+	keywords *regexp.Regexp // matches a close keyword at the end of the text
+}
+
+// NewRefMatcher builds a matcher. pattern is an optional regexp whose
+// first capture group is the issue number, e.g. `ISSUE-(\d+)`. keywords
+// replace DefaultCloseKeywords when not empty.
+func NewRefMatcher(pattern string, keywords []string) (*RefMatcher, error) {
+	m := &RefMatcher{}
+
+	if pattern != "" {
+		re, err := CompileRefPattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		m.extra = re
+	}
+
+	if len(keywords) == 0 {
+		keywords = DefaultCloseKeywords
+	}
+	quoted := make([]string, len(keywords))
+	for i, k := range keywords {
+		quoted[i] = regexp.QuoteMeta(strings.TrimSpace(k))
+	}
+	m.keywords = regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `):?\s*$`)
+
+	return m, nil
+}
+
+// DefaultRefMatcher returns a matcher for #N with the default keywords.
+func DefaultRefMatcher() *RefMatcher {
+	m, _ := NewRefMatcher("", nil)
+	return m
+}
+
+// CompileRefPattern compiles a reference pattern and checks that it
+// captures the issue number.
+func CompileRefPattern(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference pattern %q: %w", pattern, err)
+	}
+	if re.NumSubexp() < 1 {
+		return nil, fmt.Errorf("invalid reference pattern %q: needs a capture group for the issue number", pattern)
+	}
+	return re, nil
+}
+
+// Match returns the issues referenced in text, in order of first
+// appearance. An issue counts as resolved if any of its references is
+// preceded by a close keyword. References inside code and URLs are
+// ignored (see RefText).
+func (m *RefMatcher) Match(text string) []CommitRef {
+	text = RefText(text)
+
+	type found struct {
+		pos int
+		ref CommitRef
+	}
+	var all []found
+	collect := func(re *regexp.Regexp) {
+		for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+			if loc[2] < 0 {
+				continue
+			}
+			n, err := strconv.Atoi(text[loc[2]:loc[3]])
+			if err != nil || n <= 0 {
+				continue
+			}
+			resolves := m.keywords.MatchString(text[:loc[0]])
+			all = append(all, found{loc[0], CommitRef{Number: n, Resolves: resolves}})
+		}
+	}
+	collect(refPattern)
+	if m.extra != nil {
+		collect(m.extra)
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].pos < all[j].pos })
+
+	var refs []CommitRef
+	index := make(map[int]int)
+	for _, f := range all {
+		if i, ok := index[f.ref.Number]; ok {
+			refs[i].Resolves = refs[i].Resolves || f.ref.Resolves
+			continue
+		}
+		index[f.ref.Number] = len(refs)
+		refs = append(refs, f.ref)
+	}
+	return refs
+}
+
+// Numbers returns the referenced issue numbers, in order of first appearance.
+func (m *RefMatcher) Numbers(text string) []int {
+	refs := m.Match(text)
+	if len(refs) == 0 {
+		return nil
+	}
+	numbers := make([]int, len(refs))
+	for i, r := range refs {
+		numbers[i] = r.Number
+	}
+	return numbers
+}
@@ -0,0 +1,59 @@
+package issue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRefMatcher(t *testing.T) {
+	custom, err := NewRefMatcher(`ISSUE-(\d+)`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		matcher *RefMatcher
+		text    string
+		want    []CommitRef
+	}{
+		{"plain", DefaultRefMatcher(), "Add export (#3) and #5", []CommitRef{{3, false}, {5, false}}},
+		{"keyword", DefaultRefMatcher(), "Fixes #3, see #5", []CommitRef{{3, true}, {5, false}}},
+		{"keyword with colon", DefaultRefMatcher(), "closes: #7", []CommitRef{{7, true}}},
+		{"keyword inside a word", DefaultRefMatcher(), "prefixes #7", []CommitRef{{7, false}}},
+		{"resolved by a later ref", DefaultRefMatcher(), "Start #4\n\nResolves #4", []CommitRef{{4, true}}},
+		{"prefix not configured", DefaultRefMatcher(), "ISSUE-12", nil},
+		{"prefix", custom, "Fix ISSUE-12 and #3", []CommitRef{{12, true}, {3, false}}},
+		{"code is skipped", custom, "fixes `ISSUE-9` and #2", []CommitRef{{2, false}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher.Match(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Match(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefMatcherKeywords(t *testing.T) {
+	m, err := NewRefMatcher("", []string{"done"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []CommitRef{{1, true}, {2, false}}
+	if got := m.Match("done #1, fixes #2"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Match() = %v, want %v", got, want)
+	}
+}
+
+func TestCompileRefPattern(t *testing.T) {
+	for _, pattern := range []string{`ISSUE-\d+`, `ISSUE-(\d+`} {
+		if _, err := CompileRefPattern(pattern); err == nil {
+			t.Errorf("CompileRefPattern(%q) should fail", pattern)
+		}
+	}
+	if _, err := CompileRefPattern(`[A-Z]+-(\d+)`); err != nil {
+		t.Errorf("CompileRefPattern() error = %v", err)
+	}
+}
@@ -53,20 +53,7 @@ type ConflictDetector struct {
 
 // NewConflictDetector creates a new conflict detector.
 func NewConflictDetector(baseDir string) *ConflictDetector {
-	cd := &ConflictDetector{baseDir: baseDir}
-	cd.gitRoot = cd.findGitRoot()
-	return cd
-}
-
-// findGitRoot finds the git repository root.
-func (cd *ConflictDetector) findGitRoot() string {
-	cmd := exec.Command("git", "rev-parse", "--show-toplevel")
-	cmd.Dir = cd.baseDir
-	out, err := cmd.Output()
-	if err != nil {
-		return ""
-	}
-	return strings.TrimSpace(string(out))
+	return &ConflictDetector{baseDir: baseDir, gitRoot: GitRoot(baseDir)}
 }
 
 // DetectConflicts scans the issues directory and detects all conflicts.
@@ -98,10 +85,50 @@ func (cd *ConflictDetector) DetectConflicts() ([]*Conflict, error) {
 
 // loadAllFiles loads information about all .md files in the issues directory.
 func (cd *ConflictDetector) loadAllFiles() ([]*FileInfo, error) {
-	entries, err := os.ReadDir(cd.baseDir)
+	files, err := scanFiles(cd.baseDir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read issues directory: %w", err)
 	}
+	for _, fi := range files {
+		fi.GitCreatedAt = cd.getGitCreatedAt(fi.FilePath)
+	}
+	return files, nil
+}
+
+// NumberInUse returns the file that already uses number, in its filename or
+// its frontmatter, so a new issue with that number would create a conflict.
+// Unlike DetectConflicts it also looks at the legacy {state}/ directories,
+// since NextNumber counts those. It returns an empty string when the number
+// is free, including when the issues directory does not exist yet.
+func (cd *ConflictDetector) NumberInUse(number int) (string, error) {
+	dirs := []string{cd.baseDir}
+	for _, state := range AllStates() {
+		dirs = append(dirs, filepath.Join(cd.baseDir, StateDir(state)))
+	}
+	for _, dir := range dirs {
+		files, err := scanFiles(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read issues directory: %w", err)
+		}
+		for _, fi := range files {
+			if fi.FilenameNumber == number || fi.FrontmatterNum == number {
+				return fi.FileName, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// scanFiles reads the filename and frontmatter numbers of all .md files in
+// dir, without consulting git.
+func scanFiles(dir string) ([]*FileInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
 
 	var files []*FileInfo
 	filenamePattern := regexp.MustCompile(`^(\d+)-`)
@@ -111,7 +138,7 @@ func (cd *ConflictDetector) loadAllFiles() ([]*FileInfo, error) {
 			continue
 		}
 
-		filePath := filepath.Join(cd.baseDir, entry.Name())
+		filePath := filepath.Join(dir, entry.Name())
 		fi := &FileInfo{
 			FilePath: filePath,
 			FileName: entry.Name(),
@@ -134,9 +161,6 @@ func (cd *ConflictDetector) loadAllFiles() ([]*FileInfo, error) {
 			fi.CreatedAt = issue.CreatedAt
 		}
 
-		// Get git creation time
-		fi.GitCreatedAt = cd.getGitCreatedAt(filePath)
-
 		files = append(files, fi)
 	}
 
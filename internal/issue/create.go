@@ -0,0 +1,243 @@
+package issue
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// lockFileName guards number assignment while an issue is being created.
+const lockFileName = ".lock"
+
+// Lock acquisition timing. A lock older than lockStale is assumed to be
+// left behind by a crashed process and is removed.
+var (
+	lockTimeout = 5 * time.Second
+	lockStale   = 30 * time.Second
+)
+
+// slugPrefixPattern matches conventional commit prefixes stripped from slugs
+var slugPrefixPattern = regexp.MustCompile(`^(feat|fix|docs|chore|refactor|test|style|perf|ci|build):\s*`)
+
+// Create assigns the next issue number, fills in missing timestamps and
+// writes the issue to a new file in the store's directory.
+// Number assignment and the write happen under a lock file, so concurrent
+// creates in the same directory never get the same number.
+// Returns the assigned number; issue.Number and issue.FilePath are updated.
+func (s *Store) Create(issue *Issue) (int, error) {
+	if err := os.MkdirAll(s.baseDir, 0755); err != nil {
+		return 0, fmt.Errorf("failed to create issues directory: %w", err)
+	}
+
+	unlock, err := s.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	filename, data, err := s.PreviewCreate(issue)
+	if err != nil {
+		return 0, err
+	}
+
+	filePath := filepath.Join(s.baseDir, filename)
+	f, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create issue file: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(filePath)
+		return 0, fmt.Errorf("failed to write issue file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return 0, fmt.Errorf("failed to write issue file: %w", err)
+	}
+
+	issue.FilePath = filePath
+	return issue.Number, nil
+}
+
+// PreviewCreate returns the filename and content Create would write, without
+// writing anything. issue.Number and missing timestamps are filled in.
+// A number already set on the issue is kept, provided no file uses it yet.
+func (s *Store) PreviewCreate(issue *Issue) (string, []byte, error) {
+	number := issue.Number
+	if number > 0 {
+		if err := s.checkNumberFree(number); err != nil {
+			return "", nil, err
+		}
+	} else {
+		next, err := s.NextNumber()
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to determine next issue number: %w", err)
+		}
+		number = next
+	}
+	issue.Number = number
+
+	now := time.Now().UTC()
+	if issue.CreatedAt.IsZero() {
+		issue.CreatedAt = now
+	}
+	if issue.UpdatedAt.IsZero() {
+		issue.UpdatedAt = now
+	}
+	if err := issue.Validate(); err != nil {
+		return "", nil, err
+	}
+
+	data, err := Serialize(issue)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to serialize issue: %w", err)
+	}
+
+	return Filename(number, issue.Title), data, nil
+}
+
+// NextNumber returns the next available issue number.
+// Files that fail to parse still reserve the number in their filename.
+func (s *Store) NextNumber() (int, error) {
+	issues, err := s.List(AllStates()...)
+	if err != nil {
+		return 0, err
+	}
+
+	maxNumber := 0
+	for _, iss := range issues {
+		if iss.Number > maxNumber {
+			maxNumber = iss.Number
+		}
+	}
+	for _, w := range s.warnings {
+		if num := NumberFromFilename(w.FileName); num > maxNumber {
+			maxNumber = num
+		}
+	}
+
+	return maxNumber + 1, nil
+}
+
+// checkNumberFree returns an error if an issue file already uses number in
+// its filename or frontmatter.
+func (s *Store) checkNumberFree(number int) error {
+	existing, err := NewConflictDetector(s.baseDir).NumberInUse(number)
+	if err != nil {
+		return err
+	}
+	if existing != "" {
+		return fmt.Errorf("issue #%d already exists: %s", number, existing)
+	}
+	return nil
+}
+
+// lock creates the lock file, waiting for another process to release it.
+func (s *Store) lock() (func(), error) {
+	path := filepath.Join(s.baseDir, lockFileName)
+	deadline := time.Now().Add(lockTimeout)
+
+	for {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			fmt.Fprintf(f, "%d\n", os.Getpid())
+			f.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to create lock file: %w", err)
+		}
+
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > lockStale {
+			os.Remove(path)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for %s (remove it if no other zap is running)", path)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// Filename returns the file name for an issue: "NNN-slug.md".
+func Filename(number int, title string) string {
+	return fmt.Sprintf("%03d-%s.md", number, Slug(title))
+}
+
+// NumberFromFilename extracts the issue number from a filename.
+// Supports formats: "NNN-title.md", "N-title.md", etc. Returns 0 if none.
+func NumberFromFilename(filename string) int {
+	name := strings.TrimSuffix(filename, ".md")
+
+	idx := strings.Index(name, "-")
+	if idx == -1 {
+		return 0
+	}
+
+	num, err := strconv.Atoi(name[:idx])
+	if err != nil {
+		return 0
+	}
+	return num
+}
+
+// Slug creates a URL-friendly slug from the title.
+// Supports Korean and other Unicode characters.
+func Slug(title string) string {
+	// Normalize Unicode (NFC)
+	title = norm.NFC.String(title)
+
+	// Convert to lowercase
+	title = strings.ToLower(title)
+
+	// Remove common prefixes like "feat:", "fix:", "docs:", etc.
+	title = slugPrefixPattern.ReplaceAllString(title, "")
+
+	// Replace spaces and underscores with hyphens
+	title = strings.ReplaceAll(title, " ", "-")
+	title = strings.ReplaceAll(title, "_", "-")
+
+	// Keep only alphanumeric, Korean, and hyphens
+	var result strings.Builder
+	prevHyphen := false
+	for _, r := range title {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			result.WriteRune(r)
+			prevHyphen = false
+		} else if r == '-' && !prevHyphen && result.Len() > 0 {
+			result.WriteRune('-')
+			prevHyphen = true
+		}
+	}
+
+	slug := result.String()
+
+	// Remove trailing hyphen
+	slug = strings.TrimSuffix(slug, "-")
+
+	// Limit length to 50 characters (cut at word boundary if possible)
+	if len(slug) > 50 {
+		// Try to cut at a hyphen boundary
+		truncated := slug[:50]
+		lastHyphen := strings.LastIndex(truncated, "-")
+		if lastHyphen > 30 {
+			slug = truncated[:lastHyphen]
+		} else {
+			slug = truncated
+		}
+	}
+
+	// Ensure slug is not empty
+	if slug == "" {
+		slug = "issue"
+	}
+
+	return slug
+}
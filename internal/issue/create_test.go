@@ -0,0 +1,406 @@
+package issue
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{
+			name:     "simple english",
+			title:    "Fix login bug",
+			expected: "fix-login-bug",
+		},
+		{
+			name:     "with prefix feat:",
+			title:    "feat: Add user authentication",
+			expected: "add-user-authentication",
+		},
+		{
+			name:     "with prefix fix:",
+			title:    "fix: Handle null pointer",
+			expected: "handle-null-pointer",
+		},
+		{
+			name:     "korean only",
+			title:    "사용자 인증 추가",
+			expected: "사용자-인증-추가",
+		},
+		{
+			name:     "mixed korean and english",
+			title:    "User 인증 기능 추가",
+			expected: "user-인증-기능-추가",
+		},
+		{
+			name:     "special characters",
+			title:    "Fix bug #123: Handle @mention",
+			expected: "fix-bug-123-handle-mention",
+		},
+		{
+			name:     "multiple spaces",
+			title:    "Fix   multiple   spaces",
+			expected: "fix-multiple-spaces",
+		},
+		{
+			name:     "underscores",
+			title:    "fix_underscore_title",
+			expected: "fix-underscore-title",
+		},
+		{
+			name:     "long title truncation",
+			title:    "This is a very long title that should be truncated at fifty characters boundary",
+			expected: "this-is-a-very-long-title-that-should-be",
+		},
+		{
+			name:     "empty after processing",
+			title:    "!@#$%",
+			expected: "issue",
+		},
+		{
+			name:     "numbers",
+			title:    "Update API v2 endpoints",
+			expected: "update-api-v2-endpoints",
+		},
+		{
+			name:     "leading special chars",
+			title:    "## Fix header bug",
+			expected: "fix-header-bug",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Slug(tt.title)
+			if result != tt.expected {
+				t.Errorf("Slug(%q) = %q, want %q", tt.title, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNumberFromFilename(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		expected int
+	}{
+		{
+			name:     "three digit padded",
+			filename: "001-feat-login.md",
+			expected: 1,
+		},
+		{
+			name:     "two digit",
+			filename: "24-fix-bug.md",
+			expected: 24,
+		},
+		{
+			name:     "large number",
+			filename: "999-final-issue.md",
+			expected: 999,
+		},
+		{
+			name:     "no hyphen",
+			filename: "readme.md",
+			expected: 0,
+		},
+		{
+			name:     "non-numeric prefix",
+			filename: "abc-title.md",
+			expected: 0,
+		},
+		{
+			name:     "empty filename",
+			filename: "",
+			expected: 0,
+		},
+		{
+			name:     "just number",
+			filename: "123-.md",
+			expected: 123,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NumberFromFilename(tt.filename)
+			if result != tt.expected {
+				t.Errorf("NumberFromFilename(%q) = %d, want %d", tt.filename, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStoreNextNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string // filename -> content
+		expected int
+	}{
+		{
+			name:     "empty directory",
+			files:    map[string]string{},
+			expected: 1,
+		},
+		{
+			name: "sequential issues",
+			files: map[string]string{
+				"001-first.md": `---
+number: 1
+title: "First"
+state: open
+---`,
+				"002-second.md": `---
+number: 2
+title: "Second"
+state: open
+---`,
+			},
+			expected: 3,
+		},
+		{
+			name: "with gap",
+			files: map[string]string{
+				"001-first.md": `---
+number: 1
+title: "First"
+state: open
+---`,
+				"005-fifth.md": `---
+number: 5
+title: "Fifth"
+state: open
+---`,
+			},
+			expected: 6,
+		},
+		{
+			name: "with parse failure",
+			files: map[string]string{
+				"001-first.md": `---
+number: 1
+title: "First"
+state: open
+---`,
+				"003-broken.md": `invalid content without frontmatter`,
+			},
+			expected: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create temp directory
+			tmpDir, err := os.MkdirTemp("", "zap-test-*")
+			if err != nil {
+				t.Fatalf("Failed to create temp dir: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			// Create test files
+			for filename, content := range tt.files {
+				filePath := filepath.Join(tmpDir, filename)
+				if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+					t.Fatalf("Failed to create test file: %v", err)
+				}
+			}
+
+			// Test
+			store := NewStore(tmpDir)
+			result, err := store.NextNumber()
+			if err != nil {
+				t.Fatalf("NextNumber failed: %v", err)
+			}
+
+			if result != tt.expected {
+				t.Errorf("NextNumber() = %d, want %d", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStoreCreate(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	store := NewStore(dir)
+
+	first := &Issue{Title: "feat: Add login", State: StateOpen}
+	number, err := store.Create(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if number != 1 || first.Number != 1 {
+		t.Errorf("Create() = %d (issue.Number %d), want 1", number, first.Number)
+	}
+	if want := filepath.Join(dir, "001-add-login.md"); first.FilePath != want {
+		t.Errorf("FilePath = %q, want %q", first.FilePath, want)
+	}
+	if first.CreatedAt.IsZero() || first.UpdatedAt.IsZero() {
+		t.Error("timestamps were not filled in")
+	}
+
+	second := &Issue{Title: "버그 수정", State: StateWip, Body: "Details"}
+	if number, err := store.Create(second); err != nil || number != 2 {
+		t.Fatalf("Create() = %d, %v, want 2", number, err)
+	}
+
+	got, err := store.Get(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "버그 수정" || got.State != StateWip || got.Body != "Details" {
+		t.Errorf("Get(2) = %+v", got)
+	}
+	if filepath.Base(got.FilePath) != "002-버그-수정.md" {
+		t.Errorf("filename = %q", filepath.Base(got.FilePath))
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, lockFileName)); !os.IsNotExist(err) {
+		t.Errorf("lock file left behind: %v", err)
+	}
+}
+
+func TestStoreCreateWithNumber(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	store := NewStore(dir)
+
+	if _, err := store.Create(&Issue{Title: "First", State: StateOpen}); err != nil {
+		t.Fatal(err)
+	}
+	// A file whose frontmatter number differs from its filename reserves both
+	writeTestIssue(t, dir, "003-moved.md", 7)
+
+	restored := &Issue{Number: 5, Title: "Restored", State: StateOpen}
+	if number, err := store.Create(restored); err != nil || number != 5 {
+		t.Fatalf("Create() = %d, %v, want 5", number, err)
+	}
+	if filepath.Base(restored.FilePath) != "005-restored.md" {
+		t.Errorf("filename = %q", filepath.Base(restored.FilePath))
+	}
+
+	for _, number := range []int{1, 3, 5, 7} {
+		if _, err := store.Create(&Issue{Number: number, Title: "Dup", State: StateOpen}); err == nil || !strings.Contains(err.Error(), "already exists") {
+			t.Errorf("Create() with number %d = %v, want conflict error", number, err)
+		}
+	}
+
+	if next, err := store.NextNumber(); err != nil || next != 8 {
+		t.Errorf("NextNumber() = %d, %v, want 8", next, err)
+	}
+}
+
+func TestStoreCreateWithNumberLegacy(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	if err := os.MkdirAll(filepath.Join(dir, "open"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeTestIssue(t, filepath.Join(dir, "open"), "003-old.md", 3)
+	store := NewStore(dir)
+
+	if _, err := store.Create(&Issue{Number: 3, Title: "Dup", State: StateOpen}); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Create() with number 3 = %v, want conflict error", err)
+	}
+	if number, err := store.Create(&Issue{Number: 4, Title: "Free", State: StateOpen}); err != nil || number != 4 {
+		t.Errorf("Create() = %d, %v, want 4", number, err)
+	}
+}
+
+func TestStorePreviewCreateWithNumberNoDir(t *testing.T) {
+	// The issues directory is only created by Create, so a preview must not
+	// fail on the missing directory
+	dir := filepath.Join(t.TempDir(), ".issues")
+	store := NewStore(dir)
+
+	name, _, err := store.PreviewCreate(&Issue{Number: 5, Title: "First", State: StateOpen})
+	if err != nil || name != "005-first.md" {
+		t.Errorf("PreviewCreate() = %q, %v, want 005-first.md", name, err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("PreviewCreate() created %s", dir)
+	}
+}
+
+func TestStoreCreateInvalid(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	store := NewStore(dir)
+
+	if _, err := store.Create(&Issue{Title: "", State: StateOpen}); err == nil || !strings.Contains(err.Error(), "title is required") {
+		t.Errorf("Create() with empty title = %v, want validation error", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("invalid issue was written: %v", entries)
+	}
+}
+
+func TestStoreCreateConcurrent(t *testing.T) {
+	dir := t.TempDir()
+
+	const n = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Separate stores, as separate processes would have
+			_, err := NewStore(dir).Create(&Issue{Title: fmt.Sprintf("Issue %d", i), State: StateOpen})
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	issues, err := NewStore(dir).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[int]bool)
+	for _, iss := range issues {
+		if seen[iss.Number] {
+			t.Errorf("number %d assigned twice", iss.Number)
+		}
+		seen[iss.Number] = true
+		if !strings.HasPrefix(filepath.Base(iss.FilePath), fmt.Sprintf("%03d-", iss.Number)) {
+			t.Errorf("filename %s does not match number %d", iss.FilePath, iss.Number)
+		}
+	}
+	if len(seen) != n {
+		t.Errorf("created %d issues, want %d", len(seen), n)
+	}
+}
+
+func TestStoreCreateStaleLock(t *testing.T) {
+	dir := t.TempDir()
+	lockPath := filepath.Join(dir, lockFileName)
+	if err := os.WriteFile(lockPath, []byte("1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := lockStale
+	lockStale = 0
+	defer func() { lockStale = old }()
+
+	if _, err := NewStore(dir).Create(&Issue{Title: "After crash", State: StateOpen}); err != nil {
+		t.Fatalf("Create() with stale lock: %v", err)
+	}
+}
+
+func writeTestIssue(t *testing.T, dir, name string, number int) {
+	t.Helper()
+	content := fmt.Sprintf("---\nnumber: %d\ntitle: Test\nstate: open\ncreated_at: 2024-01-01T00:00:00Z\nupdated_at: 2024-01-01T00:00:00Z\n---\n", number)
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,144 @@
+package issue
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// titleWeight is the share of the title in Similarity when both issues
+// have a body; the body makes up the rest.
+const titleWeight = 0.6
+
+// DuplicatePair is two issues whose similarity reached the threshold.
+type DuplicatePair struct {
+	A, B  *Issue // A has the lower number
+	Score float64
+}
+
+// Similarity returns how alike two issues are, from 0 to 1, as the Jaccard
+// index of character trigrams over normalized title and body text.
+// If either body is empty, only the titles are compared.
+func Similarity(a, b *Issue) float64 {
+	return newIssueTrigrams(a).similarity(newIssueTrigrams(b))
+}
+
+// issueTrigrams holds the trigram sets of an issue's title and body.
+type issueTrigrams struct {
+	title, body map[string]bool
+}
+
+// newIssueTrigrams builds the trigram sets of iss.
+func newIssueTrigrams(iss *Issue) issueTrigrams {
+	return issueTrigrams{title: trigrams(iss.Title), body: trigrams(iss.Body)}
+}
+
+// similarity is Similarity on precomputed trigram sets.
+func (a issueTrigrams) similarity(b issueTrigrams) float64 {
+	title := jaccard(a.title, b.title)
+	if len(a.body) == 0 || len(b.body) == 0 {
+		return title
+	}
+	return titleWeight*title + (1-titleWeight)*jaccard(a.body, b.body)
+}
+
+// FindDuplicatePairs compares every pair of issues and returns those with a
+// similarity of at least threshold, most similar first.
+func FindDuplicatePairs(issues []*Issue, threshold float64) []DuplicatePair {
+	sorted := make([]*Issue, len(issues))
+	copy(sorted, issues)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Number < sorted[j].Number })
+
+	// Each issue's trigrams are built once rather than once per pair
+	sets := make([]issueTrigrams, len(sorted))
+	for i, iss := range sorted {
+		sets[i] = newIssueTrigrams(iss)
+	}
+
+	var pairs []DuplicatePair
+	for i := 0; i < len(sorted); i++ {
+		for j := i + 1; j < len(sorted); j++ {
+			if score := sets[i].similarity(sets[j]); score >= threshold {
+				pairs = append(pairs, DuplicatePair{A: sorted[i], B: sorted[j], Score: score})
+			}
+		}
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].Score > pairs[j].Score })
+	return pairs
+}
+
+// GroupDuplicates joins pairs that share an issue into groups, so that
+// #1~#2 and #2~#3 end up together. Issues in a group are ordered by number;
+// groups are ordered by their lowest number.
+func GroupDuplicates(pairs []DuplicatePair) [][]*Issue {
+	parent := make(map[int]int)
+	issues := make(map[int]*Issue)
+	var find func(n int) int
+	find = func(n int) int {
+		if parent[n] != n {
+			parent[n] = find(parent[n])
+		}
+		return parent[n]
+	}
+
+	for _, p := range pairs {
+		for _, iss := range []*Issue{p.A, p.B} {
+			if _, ok := parent[iss.Number]; !ok {
+				parent[iss.Number] = iss.Number
+				issues[iss.Number] = iss
+			}
+		}
+		ra, rb := find(p.A.Number), find(p.B.Number)
+		if ra != rb {
+			parent[max(ra, rb)] = min(ra, rb)
+		}
+	}
+
+	byRoot := make(map[int][]*Issue)
+	for n, iss := range issues {
+		root := find(n)
+		byRoot[root] = append(byRoot[root], iss)
+	}
+
+	var groups [][]*Issue
+	for _, group := range byRoot {
+		sort.Slice(group, func(i, j int) bool { return group[i].Number < group[j].Number })
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i][0].Number < groups[j][0].Number })
+	return groups
+}
+
+// trigrams returns the set of character trigrams of text after lowercasing
+// and collapsing everything but letters and digits into single spaces.
+// Words are padded so that short words still yield trigrams.
+func trigrams(text string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return nil
+	}
+
+	runes := []rune(" " + strings.Join(words, " ") + " ")
+	set := make(map[string]bool)
+	for i := 0; i+3 <= len(runes); i++ {
+		set[string(runes[i:i+3])] = true
+	}
+	return set
+}
+
+// jaccard returns |a ∩ b| / |a ∪ b|, or 0 if both sets are empty.
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for k := range a {
+		if b[k] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
@@ -0,0 +1,102 @@
+package issue
+
+import "testing"
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    *Issue
+		atLeast float64
+		below   float64
+	}{
+		{
+			name:    "identical titles",
+			a:       &Issue{Title: "Fix login crash"},
+			b:       &Issue{Title: "fix: Login crash!"},
+			atLeast: 0.6,
+			below:   1.01,
+		},
+		{
+			name:    "unrelated titles",
+			a:       &Issue{Title: "Fix login crash"},
+			b:       &Issue{Title: "Add CSV export"},
+			atLeast: 0,
+			below:   0.2,
+		},
+		{
+			name:    "similar titles, different bodies",
+			a:       &Issue{Title: "Login crash", Body: "Crashes when the password is empty."},
+			b:       &Issue{Title: "Login crash", Body: "Dark mode colors are wrong in settings."},
+			atLeast: 0.6,
+			below:   0.9,
+		},
+		{
+			name:    "empty titles",
+			a:       &Issue{},
+			b:       &Issue{},
+			atLeast: 0,
+			below:   0.01,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Similarity(tt.a, tt.b)
+			if got < tt.atLeast || got >= tt.below {
+				t.Errorf("Similarity() = %.2f, want in [%.2f, %.2f)", got, tt.atLeast, tt.below)
+			}
+			if back := Similarity(tt.b, tt.a); back != got {
+				t.Errorf("Similarity is not symmetric: %.2f vs %.2f", got, back)
+			}
+		})
+	}
+}
+
+func TestFindAndGroupDuplicates(t *testing.T) {
+	issues := []*Issue{
+		{Number: 4, Title: "Login page crashes"},
+		{Number: 1, Title: "Login page crash"},
+		{Number: 2, Title: "Add CSV export"},
+		{Number: 7, Title: "Login page crashes on submit"},
+		{Number: 5, Title: "Add CSV exports"},
+		{Number: 9, Title: "Dark mode"},
+	}
+
+	pairs := FindDuplicatePairs(issues, 0.6)
+	if len(pairs) == 0 {
+		t.Fatal("expected duplicate pairs")
+	}
+	for i, p := range pairs {
+		if p.A.Number >= p.B.Number {
+			t.Errorf("pair %d not ordered by number: #%d, #%d", i, p.A.Number, p.B.Number)
+		}
+		if i > 0 && p.Score > pairs[i-1].Score {
+			t.Errorf("pairs not sorted by score")
+		}
+	}
+
+	groups := GroupDuplicates(pairs)
+	var got [][]int
+	for _, g := range groups {
+		var numbers []int
+		for _, iss := range g {
+			numbers = append(numbers, iss.Number)
+		}
+		got = append(got, numbers)
+	}
+
+	want := [][]int{{1, 4, 7}, {2, 5}}
+	if len(got) != len(want) {
+		t.Fatalf("groups = %v, want %v", got, want)
+	}
+	for i := range want {
+		if len(got[i]) != len(want[i]) {
+			t.Fatalf("groups = %v, want %v", got, want)
+		}
+		for j := range want[i] {
+			if got[i][j] != want[i][j] {
+				t.Fatalf("groups = %v, want %v", got, want)
+			}
+		}
+	}
+}
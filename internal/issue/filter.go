@@ -0,0 +1,82 @@
+package issue
+
+import "strings"
+
+// Filter selects issues by labels and assignees (case-insensitive).
+// Within each list, MatchAll requires every value to match; otherwise any
+// one is enough. When both lists are set, an issue must satisfy both.
+// Issues that pass are then dropped if they carry any excluded label or
+// assignee.
+type Filter struct {
+	Labels           []string
+	Assignees        []string
+	MatchAll         bool
+	Unassigned       bool // Only issues without assignees
+	ExcludeLabels    []string
+	ExcludeAssignees []string
+}
+
+// IsEmpty reports whether the filter has no conditions.
+func (f Filter) IsEmpty() bool {
+	return len(f.Labels) == 0 && len(f.Assignees) == 0 && !f.Unassigned &&
+		len(f.ExcludeLabels) == 0 && len(f.ExcludeAssignees) == 0
+}
+
+// Match reports whether an issue passes the filter.
+func (f Filter) Match(issue *Issue) bool {
+	if f.Unassigned && len(issue.Assignees) > 0 {
+		return false
+	}
+	if !f.matchValues(f.Labels, issue.Labels) || !f.matchValues(f.Assignees, issue.Assignees) {
+		return false
+	}
+	return !containsAny(f.ExcludeLabels, issue.Labels) && !containsAny(f.ExcludeAssignees, issue.Assignees)
+}
+
+// Apply returns the issues that pass the filter.
+func (f Filter) Apply(issues []*Issue) []*Issue {
+	if f.IsEmpty() {
+		return issues
+	}
+	var results []*Issue
+	for _, issue := range issues {
+		if f.Match(issue) {
+			results = append(results, issue)
+		}
+	}
+	return results
+}
+
+func (f Filter) matchValues(want, have []string) bool {
+	if len(want) == 0 {
+		return true
+	}
+	for _, w := range want {
+		found := false
+		for _, h := range have {
+			if strings.EqualFold(w, h) {
+				found = true
+				break
+			}
+		}
+		if found && !f.MatchAll {
+			return true
+		}
+		if !found && f.MatchAll {
+			return false
+		}
+	}
+	return f.MatchAll
+}
+
+// containsAny reports whether any of want is in have (case-insensitive).
+func containsAny(want, have []string) bool {
+	for _, w := range want {
+		for _, h := range have {
+			if strings.EqualFold(w, h) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package issue
+
+import "testing"
+
+func TestFilterMatch(t *testing.T) {
+	iss := &Issue{Labels: []string{"bug", "UI"}, Assignees: []string{"alice"}}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"empty", Filter{}, true},
+		{"single label", Filter{Labels: []string{"bug"}}, true},
+		{"case-insensitive", Filter{Labels: []string{"ui"}}, true},
+		{"any of labels", Filter{Labels: []string{"docs", "bug"}}, true},
+		{"all of labels", Filter{Labels: []string{"bug", "ui"}, MatchAll: true}, true},
+		{"all of labels, one missing", Filter{Labels: []string{"bug", "docs"}, MatchAll: true}, false},
+		{"none of labels", Filter{Labels: []string{"docs"}}, false},
+		{"label and assignee", Filter{Labels: []string{"bug"}, Assignees: []string{"alice"}}, true},
+		{"label and wrong assignee", Filter{Labels: []string{"bug"}, Assignees: []string{"bob"}}, false},
+		{"any of assignees", Filter{Assignees: []string{"bob", "Alice"}}, true},
+		{"unassigned", Filter{Unassigned: true}, false},
+		{"excluded label", Filter{ExcludeLabels: []string{"Bug"}}, false},
+		{"excluded label not present", Filter{ExcludeLabels: []string{"docs"}}, true},
+		{"include then exclude", Filter{Labels: []string{"bug"}, ExcludeAssignees: []string{"alice"}}, false},
+		{"excluded assignee not present", Filter{Labels: []string{"bug"}, ExcludeAssignees: []string{"bob"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(iss); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package issue
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// GitRoot returns the root of the git repository containing dir,
+// or "" if dir is not inside a git repository.
+func GitRoot(dir string) string {
+	cmd := exec.Command("git", "rev-parse", "--show-toplevel")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// ModifiedFiles returns the files under dir with uncommitted changes,
+// mapped from absolute path, with symlinks resolved, to their two-letter
+// `git status --porcelain` code (e.g. " M", "A ", "??").
+func ModifiedFiles(dir string) (map[string]string, error) {
+	root := GitRoot(dir)
+	if root == "" {
+		return nil, fmt.Errorf("%s is not in a git repository", dir)
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	// Paths are returned with symlinks resolved, so they compare equal to
+	// issue paths resolved the same way
+	if resolved, err := filepath.EvalSymlinks(absDir); err == nil {
+		absDir = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(root); err == nil {
+		root = resolved
+	}
+
+	cmd := exec.Command("git", "status", "--porcelain", "-z", "--untracked-files=all", "--", absDir)
+	cmd.Dir = root
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git status failed: %w", err)
+	}
+
+	return parsePorcelainZ(out, root), nil
+}
+
+// parsePorcelainZ parses `git status --porcelain -z` output.
+// Paths are relative to the repository root.
+func parsePorcelainZ(out []byte, root string) map[string]string {
+	files := make(map[string]string)
+
+	entries := bytes.Split(out, []byte{0})
+	for i := 0; i < len(entries); i++ {
+		entry := string(entries[i])
+		if len(entry) < 4 {
+			continue
+		}
+
+		code, path := entry[:2], entry[3:]
+		files[filepath.Join(root, filepath.FromSlash(path))] = code
+
+		// Renames and copies are followed by the original path
+		if code[0] == 'R' || code[0] == 'C' {
+			i++
+		}
+	}
+
+	return files
+}
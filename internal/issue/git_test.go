@@ -0,0 +1,26 @@
+package issue
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestParsePorcelainZ(t *testing.T) {
+	out := []byte(" M .issues/001-a.md\x00?? .issues/002-b.md\x00R  .issues/003-new.md\x00.issues/003-old.md\x00")
+
+	files := parsePorcelainZ(out, "/repo")
+
+	want := map[string]string{
+		filepath.Join("/repo", ".issues", "001-a.md"):   " M",
+		filepath.Join("/repo", ".issues", "002-b.md"):   "??",
+		filepath.Join("/repo", ".issues", "003-new.md"): "R ",
+	}
+	if len(files) != len(want) {
+		t.Fatalf("got %d files, want %d: %v", len(files), len(want), files)
+	}
+	for path, code := range want {
+		if files[path] != code {
+			t.Errorf("files[%s] = %q, want %q", path, files[path], code)
+		}
+	}
+}
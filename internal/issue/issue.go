@@ -1,6 +1,8 @@
 package issue
 
 import (
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -14,14 +16,19 @@ const (
 	StateClosed State = "closed"
 )
 
-// AllStates returns all valid states
+// AllStates returns all valid states in display order
 func AllStates() []State {
-	return []State{StateOpen, StateWip, StateDone, StateClosed}
+	return states.Names()
 }
 
 // ActiveStates returns states considered "active" (not done)
 func ActiveStates() []State {
-	return []State{StateOpen, StateWip}
+	return states.filter(func(d StateDef) bool { return d.Active })
+}
+
+// TerminalStates returns states that finish an issue (done, closed)
+func TerminalStates() []State {
+	return states.filter(func(d StateDef) bool { return d.Terminal })
 }
 
 // Issue represents a single issue
@@ -31,10 +38,34 @@ type Issue struct {
 	State     State      `yaml:"state"`
 	Labels    []string   `yaml:"labels"`
 	Assignees []string   `yaml:"assignees"`
+	Points    int        `yaml:"points,omitempty"` // effort estimate; 0 means unestimated
 	CreatedAt time.Time  `yaml:"created_at"`
 	UpdatedAt time.Time  `yaml:"updated_at"`
 	ClosedAt  *time.Time `yaml:"closed_at,omitempty"`
 
+	// Explicit links to other issues by number, kept in sync on both sides by Link
+	RelatesTo    []int `yaml:"relates_to,omitempty"`
+	Duplicates   []int `yaml:"duplicates,omitempty"`
+	DuplicatedBy []int `yaml:"duplicated_by,omitempty"`
+	Blocks       []int `yaml:"blocks,omitempty"`
+	BlockedBy    []int `yaml:"blocked_by,omitempty"`
+
+	// Attachments lists related files and URLs
+	Attachments []Attachment `yaml:"attachments,omitempty"`
+
+	// StateHistory records state transitions in chronological order
+	StateHistory []StateChange `yaml:"state_history,omitempty"`
+
+	// Extra holds frontmatter keys zap doesn't know about, preserved on Serialize
+	Extra map[string]any `yaml:"-"`
+
+	// extraOrder keeps the original order of Extra keys
+	extraOrder []string
+
+	// ParseWarnings describes known keys whose values could not be used and
+	// were kept in Extra as written
+	ParseWarnings []string `yaml:"-"`
+
 	// Body contains the markdown content after frontmatter
 	Body string `yaml:"-"`
 
@@ -42,9 +73,59 @@ type Issue struct {
 	FilePath string `yaml:"-"`
 }
 
+// StateChange represents a single state transition
+type StateChange struct {
+	State State     `yaml:"state"`
+	At    time.Time `yaml:"at"`
+}
+
+// SetState changes the state in memory, updating updated_at, closed_at and
+// the state history as of now. It does not write the file.
+func (i *Issue) SetState(newState State, now time.Time) {
+	i.State = newState
+	i.UpdatedAt = now
+	i.StateHistory = append(i.StateHistory, StateChange{State: newState, At: now})
+
+	// Handle closed_at timestamp
+	if def, ok := LookupState(newState); ok && def.Terminal {
+		i.ClosedAt = &now
+	} else {
+		i.ClosedAt = nil
+	}
+}
+
+// Validate checks the invariants every written issue must hold: a positive
+// number, a title, a valid state, non-negative points and created_at not
+// after updated_at. All problems are reported in one error.
+func (i *Issue) Validate() error {
+	var problems []string
+	if i.Number <= 0 {
+		problems = append(problems, fmt.Sprintf("number must be positive (got %d)", i.Number))
+	}
+	if strings.TrimSpace(i.Title) == "" {
+		problems = append(problems, "title is required")
+	}
+	if _, ok := ParseState(string(i.State)); !ok {
+		problems = append(problems, fmt.Sprintf("invalid state %q (valid: %s)", i.State, ValidStates()))
+	}
+	if i.Points < 0 {
+		problems = append(problems, fmt.Sprintf("points must not be negative (got %d)", i.Points))
+	}
+	if !i.CreatedAt.IsZero() && !i.UpdatedAt.IsZero() && i.UpdatedAt.Before(i.CreatedAt) {
+		problems = append(problems, fmt.Sprintf("updated_at %s is before created_at %s",
+			i.UpdatedAt.UTC().Format(time.RFC3339), i.CreatedAt.UTC().Format(time.RFC3339)))
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid issue #%d: %s", i.Number, strings.Join(problems, "; "))
+}
+
 // IsActive returns true if the issue is in an active state
 func (i *Issue) IsActive() bool {
-	return i.State == StateOpen || i.State == StateWip
+	def, ok := LookupState(i.State)
+	return ok && def.Active
 }
 
 // StateDir returns the directory name for a given state
@@ -52,18 +133,30 @@ func StateDir(s State) string {
 	return string(s)
 }
 
-// ParseState converts a string to State
+// stateAliases maps words commonly written for a state by people and
+// agents to the state itself.
+var stateAliases = map[string]State{
+	"in-progress": StateWip,
+	"inprogress":  StateWip,
+	"doing":       StateWip,
+	"started":     StateWip,
+	"cancelled":   StateClosed,
+	"canceled":    StateClosed,
+	"wontfix":     StateClosed,
+}
+
+// ParseState converts a string to State. Aliases such as "in-progress"
+// are accepted and mapped to their state.
 func ParseState(s string) (State, bool) {
-	switch s {
-	case "open":
-		return StateOpen, true
-	case "wip":
-		return StateWip, true
-	case "done":
-		return StateDone, true
-	case "closed":
-		return StateClosed, true
-	default:
-		return "", false
+	return states.Parse(s)
+}
+
+// ValidStates lists the valid states for error messages, e.g.
+// "open, wip, done, closed".
+func ValidStates() string {
+	names := make([]string, len(states))
+	for i, d := range states {
+		names[i] = string(d.Name)
 	}
+	return strings.Join(names, ", ")
 }
@@ -1,6 +1,10 @@
 package issue
 
-import "testing"
+import (
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestParseState(t *testing.T) {
 	tests := []struct {
@@ -12,9 +16,16 @@ func TestParseState(t *testing.T) {
 		{"wip", StateWip, true},
 		{"done", StateDone, true},
 		{"closed", StateClosed, true},
+		{"in-progress", StateWip, true},
+		{"inprogress", StateWip, true},
+		{"doing", StateWip, true},
+		{"started", StateWip, true},
+		{"cancelled", StateClosed, true},
+		{"canceled", StateClosed, true},
+		{"wontfix", StateClosed, true},
 		{"check", "", false},
 		{"review", "", false},
-		{"in-progress", "", false},
+		{"In-Progress", "", false},
 		{"invalid", "", false},
 		{"", "", false},
 	}
@@ -102,3 +113,42 @@ func TestIssueIsActive(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueValidate(t *testing.T) {
+	created := time.Date(2026, 1, 17, 6, 30, 0, 0, time.UTC)
+	valid := func() *Issue {
+		return &Issue{Number: 1, Title: "Test", State: StateOpen, CreatedAt: created, UpdatedAt: created}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Issue)
+		want   string // substring of the error, "" for valid
+	}{
+		{"valid", func(i *Issue) {}, ""},
+		{"zero timestamps", func(i *Issue) { i.CreatedAt, i.UpdatedAt = time.Time{}, time.Time{} }, ""},
+		{"zero number", func(i *Issue) { i.Number = 0 }, "number must be positive"},
+		{"blank title", func(i *Issue) { i.Title = "  " }, "title is required"},
+		{"invalid state", func(i *Issue) { i.State = "todo" }, `invalid state "todo"`},
+		{"negative points", func(i *Issue) { i.Points = -1 }, "points must not be negative"},
+		{"updated before created", func(i *Issue) { i.UpdatedAt = created.Add(-time.Hour) }, "is before created_at"},
+		{"several problems", func(i *Issue) { i.Title = ""; i.Points = -2 }, "title is required; points must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss := valid()
+			tt.modify(iss)
+			err := iss.Validate()
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Validate() = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package issue
+
+import (
+	"fmt"
+	"slices"
+)
+
+// LinkType is a kind of explicit relationship between two issues.
+type LinkType string
+
+const (
+	LinkRelatesTo  LinkType = "relates-to"
+	LinkDuplicates LinkType = "duplicates"
+	LinkBlocks     LinkType = "blocks"
+)
+
+// AllLinkTypes returns the link types accepted by Link.
+func AllLinkTypes() []LinkType {
+	return []LinkType{LinkRelatesTo, LinkDuplicates, LinkBlocks}
+}
+
+// ParseLinkType converts a string to a LinkType.
+func ParseLinkType(s string) (LinkType, bool) {
+	for _, t := range AllLinkTypes() {
+		if string(t) == s {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// linkLists returns the list on from that records "from <t> to" and the
+// list on to that records the reverse side.
+func linkLists(t LinkType, from, to *Issue) (*[]int, *[]int) {
+	switch t {
+	case LinkDuplicates:
+		return &from.Duplicates, &to.DuplicatedBy
+	case LinkBlocks:
+		return &from.Blocks, &to.BlockedBy
+	default:
+		return &from.RelatesTo, &to.RelatesTo
+	}
+}
+
+// Link records "from <t> to" on both issues, e.g. 5 blocks 7 adds 7 to
+// from.Blocks and 5 to to.BlockedBy. It reports whether anything changed.
+// Neither issue is written.
+func Link(from, to *Issue, t LinkType) (bool, error) {
+	if from.Number == to.Number {
+		return false, fmt.Errorf("cannot link issue #%d to itself", from.Number)
+	}
+	forward, reverse := linkLists(t, from, to)
+	changed := addNumber(forward, to.Number)
+	if addNumber(reverse, from.Number) {
+		changed = true
+	}
+	return changed, nil
+}
+
+// Unlink removes "from <t> to" from both issues and reports whether
+// anything changed. Neither issue is written.
+func Unlink(from, to *Issue, t LinkType) bool {
+	forward, reverse := linkLists(t, from, to)
+	changed := removeNumber(forward, to.Number)
+	if removeNumber(reverse, from.Number) {
+		changed = true
+	}
+	return changed
+}
+
+// addNumber adds n to a list unless it is already there, keeping it sorted.
+func addNumber(list *[]int, n int) bool {
+	if slices.Contains(*list, n) {
+		return false
+	}
+	*list = append(*list, n)
+	slices.Sort(*list)
+	return true
+}
+
+// removeNumber deletes n from a list, leaving nil when it becomes empty.
+func removeNumber(list *[]int, n int) bool {
+	i := slices.Index(*list, n)
+	if i < 0 {
+		return false
+	}
+	*list = slices.Delete(*list, i, i+1)
+	if len(*list) == 0 {
+		*list = nil
+	}
+	return true
+}
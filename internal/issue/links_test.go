@@ -0,0 +1,161 @@
+package issue
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLink(t *testing.T) {
+	tests := []struct {
+		name        string
+		linkType    LinkType
+		wantForward func(a, b *Issue) []int
+		wantReverse func(a, b *Issue) []int
+	}{
+		{"blocks", LinkBlocks,
+			func(a, b *Issue) []int { return a.Blocks },
+			func(a, b *Issue) []int { return b.BlockedBy }},
+		{"duplicates", LinkDuplicates,
+			func(a, b *Issue) []int { return a.Duplicates },
+			func(a, b *Issue) []int { return b.DuplicatedBy }},
+		{"relates-to", LinkRelatesTo,
+			func(a, b *Issue) []int { return a.RelatesTo },
+			func(a, b *Issue) []int { return b.RelatesTo }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Issue{Number: 5}
+			b := &Issue{Number: 7}
+
+			changed, err := Link(a, b, tt.linkType)
+			if err != nil || !changed {
+				t.Fatalf("Link() = %v, %v, want true, nil", changed, err)
+			}
+			if got := tt.wantForward(a, b); !slices.Equal(got, []int{7}) {
+				t.Errorf("forward list = %v, want [7]", got)
+			}
+			if got := tt.wantReverse(a, b); !slices.Equal(got, []int{5}) {
+				t.Errorf("reverse list = %v, want [5]", got)
+			}
+
+			if changed, _ := Link(a, b, tt.linkType); changed {
+				t.Error("Link() again reported a change")
+			}
+
+			if !Unlink(a, b, tt.linkType) {
+				t.Fatal("Unlink() reported no change")
+			}
+			if tt.wantForward(a, b) != nil || tt.wantReverse(a, b) != nil {
+				t.Errorf("lists not cleared: %v, %v", tt.wantForward(a, b), tt.wantReverse(a, b))
+			}
+			if Unlink(a, b, tt.linkType) {
+				t.Error("Unlink() again reported a change")
+			}
+		})
+	}
+}
+
+func TestLinkSorted(t *testing.T) {
+	a := &Issue{Number: 1}
+	for _, n := range []int{9, 3, 6} {
+		if _, err := Link(a, &Issue{Number: n}, LinkBlocks); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !slices.Equal(a.Blocks, []int{3, 6, 9}) {
+		t.Errorf("Blocks = %v, want [3 6 9]", a.Blocks)
+	}
+}
+
+func TestLinkSelf(t *testing.T) {
+	a := &Issue{Number: 5}
+	if _, err := Link(a, a, LinkBlocks); err == nil {
+		t.Error("Link() to itself should fail")
+	}
+}
+
+func TestLinkRoundTrip(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	a := &Issue{Number: 5, Title: "A", State: StateOpen, CreatedAt: now, UpdatedAt: now}
+	b := &Issue{Number: 7, Title: "B", State: StateOpen, CreatedAt: now, UpdatedAt: now}
+	if _, err := Link(a, b, LinkBlocks); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Link(a, &Issue{Number: 9}, LinkRelatesTo); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := Serialize(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseBytes(data, "005-a.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(parsed.Blocks, []int{7}) || !slices.Equal(parsed.RelatesTo, []int{9}) {
+		t.Errorf("parsed links = blocks %v, relates_to %v", parsed.Blocks, parsed.RelatesTo)
+	}
+	if parsed.BlockedBy != nil || parsed.Duplicates != nil {
+		t.Errorf("unexpected links: blocked_by %v, duplicates %v", parsed.BlockedBy, parsed.Duplicates)
+	}
+	if len(parsed.Extra) != 0 {
+		t.Errorf("link keys leaked into Extra: %v", parsed.Extra)
+	}
+}
+
+func TestParseLinkValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		want      []int
+		wantExtra bool
+	}{
+		{"list", "blocks: [3, 4]", []int{3, 4}, false},
+		{"scalar", "blocks: 3", []int{3}, false},
+		{"quoted hash", `blocks: "#4"`, []int{4}, false},
+		{"hash list", `blocks: ["#4", 5]`, []int{4, 5}, false},
+		{"malformed list", "blocks: [a]", nil, true},
+		{"mapping", "blocks: {issue: 3}", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "---\nnumber: 1\ntitle: Test\nstate: open\n" + tt.line + "\ncreated_at: 2024-01-01T00:00:00Z\nupdated_at: 2024-01-01T00:00:00Z\n---\n"
+			parsed, err := ParseBytes([]byte(content), "001-test.md")
+			if err != nil {
+				t.Fatalf("ParseBytes failed: %v", err)
+			}
+			if !slices.Equal(parsed.Blocks, tt.want) {
+				t.Errorf("Blocks = %v, want %v", parsed.Blocks, tt.want)
+			}
+			_, inExtra := parsed.Extra["blocks"]
+			if inExtra != tt.wantExtra || (len(parsed.ParseWarnings) > 0) != tt.wantExtra {
+				t.Errorf("Extra = %v, warnings = %v, want kept: %v", parsed.Extra, parsed.ParseWarnings, tt.wantExtra)
+			}
+
+			// A malformed value survives a rewrite
+			data, err := Serialize(parsed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantExtra && !strings.Contains(string(data), "blocks:") {
+				t.Errorf("blocks lost on write:\n%s", data)
+			}
+		})
+	}
+}
+
+func TestParseLinkType(t *testing.T) {
+	for _, lt := range AllLinkTypes() {
+		if got, ok := ParseLinkType(string(lt)); !ok || got != lt {
+			t.Errorf("ParseLinkType(%q) = %q, %v", lt, got, ok)
+		}
+	}
+	if _, ok := ParseLinkType("depends-on"); ok {
+		t.Error("ParseLinkType(depends-on) should fail")
+	}
+}
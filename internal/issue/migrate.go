@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -13,6 +14,7 @@ type MigrationInfo struct {
 	HasLegacyStructure bool
 	IssuesByState      map[State][]string // filename list per state
 	TotalIssues        int
+	Unparsed           []string // flat files whose state could not be read
 }
 
 // MigrateResult contains the result of migration
@@ -112,6 +114,97 @@ func (s *Store) Migrate() (*MigrateResult, error) {
 	return result, nil
 }
 
+// DetectFlatStructure lists flat issue files (.issues/*.md) grouped by their
+// frontmatter state. HasLegacyStructure reports whether any state directory
+// already contains issues.
+func (s *Store) DetectFlatStructure() (*MigrationInfo, error) {
+	legacy, err := s.DetectLegacyStructure()
+	if err != nil {
+		return nil, err
+	}
+
+	issues, failures, err := s.loadFromFlatDir()
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	info := &MigrationInfo{
+		HasLegacyStructure: legacy.HasLegacyStructure,
+		IssuesByState:      make(map[State][]string),
+	}
+	for _, iss := range issues {
+		name := filepath.Base(iss.FilePath)
+		info.IssuesByState[iss.State] = append(info.IssuesByState[iss.State], name)
+		info.TotalIssues++
+	}
+	for _, f := range failures {
+		info.Unparsed = append(info.Unparsed, f.FileName)
+	}
+	for _, files := range info.IssuesByState {
+		sort.Strings(files)
+	}
+
+	return info, nil
+}
+
+// MigrateToLegacy converts from flat to directory-based structure, moving each
+// issue into the directory for its frontmatter state. It refuses to run when
+// state directories already contain issues, since the result would mix both
+// layouts.
+func (s *Store) MigrateToLegacy() (*MigrateResult, error) {
+	result := &MigrateResult{}
+
+	info, err := s.DetectFlatStructure()
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect flat structure: %w", err)
+	}
+
+	if info.HasLegacyStructure {
+		return nil, fmt.Errorf("state directories already contain issues; refusing to mix flat and legacy structure")
+	}
+	if info.TotalIssues == 0 {
+		return nil, fmt.Errorf("no flat issues found")
+	}
+
+	for _, filename := range info.Unparsed {
+		result.Failed++
+		result.FailedFiles = append(result.FailedFiles, filename)
+		result.Errors = append(result.Errors, "failed to parse frontmatter")
+	}
+
+	for state, files := range info.IssuesByState {
+		stateDir := filepath.Join(s.baseDir, StateDir(state))
+		if err := os.MkdirAll(stateDir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create %s directory: %w", state, err)
+		}
+
+		for _, filename := range files {
+			srcPath := filepath.Join(s.baseDir, filename)
+			dstPath := filepath.Join(stateDir, filename)
+
+			if _, err := os.Stat(dstPath); err == nil {
+				result.Failed++
+				result.FailedFiles = append(result.FailedFiles, filename)
+				result.Errors = append(result.Errors, fmt.Sprintf("%s: destination file already exists", filename))
+				continue
+			}
+
+			if err := s.gitMove(srcPath, dstPath); err != nil {
+				if err := os.Rename(srcPath, dstPath); err != nil {
+					result.Failed++
+					result.FailedFiles = append(result.FailedFiles, filename)
+					result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", filename, err))
+					continue
+				}
+			}
+
+			result.Migrated++
+		}
+	}
+
+	return result, nil
+}
+
 // updateFrontmatterState ensures the frontmatter state matches the source directory
 func (s *Store) updateFrontmatterState(filePath string, state State) error {
 	issue, err := Parse(filePath)
@@ -0,0 +1,69 @@
+package issue
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func writeMigrateIssue(t *testing.T, path string, number int, state string) {
+	t.Helper()
+	content := "---\nnumber: " + strconv.Itoa(number) + "\ntitle: Test\nstate: " + state + "\n---\n"
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrateToLegacy(t *testing.T) {
+	dir := t.TempDir()
+	writeMigrateIssue(t, filepath.Join(dir, "001-a.md"), 1, "open")
+	writeMigrateIssue(t, filepath.Join(dir, "002-b.md"), 2, "done")
+	writeMigrateIssue(t, filepath.Join(dir, "003-c.md"), 3, "wip")
+	if err := os.WriteFile(filepath.Join(dir, "004-broken.md"), []byte("no frontmatter"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewStore(dir)
+	result, err := store.MigrateToLegacy()
+	if err != nil {
+		t.Fatalf("MigrateToLegacy() error = %v", err)
+	}
+	if result.Migrated != 3 || result.Failed != 1 {
+		t.Errorf("Migrated = %d, Failed = %d, want 3, 1", result.Migrated, result.Failed)
+	}
+
+	for _, path := range []string{"open/001-a.md", "done/002-b.md", "wip/003-c.md", "004-broken.md"} {
+		if _, err := os.Stat(filepath.Join(dir, path)); err != nil {
+			t.Errorf("expected %s to exist: %v", path, err)
+		}
+	}
+
+	// Round trip back to flat.
+	if _, err := store.Migrate(); err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	issues, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 3 {
+		t.Errorf("got %d issues after round trip, want 3", len(issues))
+	}
+}
+
+func TestMigrateToLegacyRefusesMixed(t *testing.T) {
+	dir := t.TempDir()
+	writeMigrateIssue(t, filepath.Join(dir, "001-a.md"), 1, "open")
+	writeMigrateIssue(t, filepath.Join(dir, "open", "002-b.md"), 2, "open")
+
+	if _, err := NewStore(dir).MigrateToLegacy(); err == nil {
+		t.Error("MigrateToLegacy() expected error for mixed structure")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "001-a.md")); err != nil {
+		t.Errorf("flat file should not have moved: %v", err)
+	}
+}
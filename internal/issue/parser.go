@@ -5,6 +5,8 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -90,11 +92,12 @@ func GetRawDatetimeInfo(filePath string) (*RawDatetimeInfo, error) {
 
 // rawFrontmatter is an intermediate struct that supports both field naming conventions
 type rawFrontmatter struct {
-	Number    int      `yaml:"number"`
-	Title     string   `yaml:"title"`
-	State     State    `yaml:"state"`
-	Labels    []string `yaml:"labels"`
-	Assignees []string `yaml:"assignees"`
+	Number    int       `yaml:"number"`
+	Title     string    `yaml:"title"`
+	State     State     `yaml:"state"`
+	Labels    []string  `yaml:"labels"`
+	Assignees []string  `yaml:"assignees"`
+	Points    yaml.Node `yaml:"points"` // See parsePoints
 
 	// Support both naming conventions
 	CreatedAt string `yaml:"created_at"`
@@ -102,6 +105,173 @@ type rawFrontmatter struct {
 	UpdatedAt string `yaml:"updated_at"`
 	Updated   string `yaml:"updated"`
 	ClosedAt  string `yaml:"closed_at"`
+
+	// Links are decoded by parseIssueNumbers
+	RelatesTo    yaml.Node `yaml:"relates_to"`
+	Duplicates   yaml.Node `yaml:"duplicates"`
+	DuplicatedBy yaml.Node `yaml:"duplicated_by"`
+	Blocks       yaml.Node `yaml:"blocks"`
+	BlockedBy    yaml.Node `yaml:"blocked_by"`
+
+	Attachments yaml.Node `yaml:"attachments"` // See parseAttachments
+
+	StateHistory []rawStateChange `yaml:"state_history"`
+}
+
+// rawStateChange is a state_history entry with an unparsed timestamp
+type rawStateChange struct {
+	State State  `yaml:"state"`
+	At    string `yaml:"at"`
+}
+
+// knownFrontmatterKeys lists the keys handled by rawFrontmatter.
+// Any other key is preserved in Issue.Extra.
+var knownFrontmatterKeys = map[string]bool{
+	"number":        true,
+	"title":         true,
+	"state":         true,
+	"labels":        true,
+	"assignees":     true,
+	"points":        true,
+	"created_at":    true,
+	"created":       true,
+	"updated_at":    true,
+	"updated":       true,
+	"closed_at":     true,
+	"relates_to":    true,
+	"duplicates":    true,
+	"duplicated_by": true,
+	"blocks":        true,
+	"blocked_by":    true,
+	"attachments":   true,
+	"state_history": true,
+}
+
+// lenientFields check known keys whose values are often written by hand in
+// another shape. A value its check rejects is kept in Issue.Extra, with a
+// warning, instead of failing the parse or being lost.
+var lenientFields = map[string]func(*yaml.Node) bool{
+	"points":        func(n *yaml.Node) bool { _, ok := parsePoints(n); return ok },
+	"relates_to":    isIssueNumbers,
+	"duplicates":    isIssueNumbers,
+	"duplicated_by": isIssueNumbers,
+	"blocks":        isIssueNumbers,
+	"blocked_by":    isIssueNumbers,
+	"attachments":   func(n *yaml.Node) bool { _, ok := parseAttachments(n); return ok },
+}
+
+// parseExtraFields collects unknown frontmatter keys in document order,
+// along with known keys whose values lenientFields rejects. It returns a
+// warning for each of the latter.
+func parseExtraFields(frontmatter []byte) (map[string]any, []string, []string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(frontmatter, &doc); err != nil {
+		return nil, nil, nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, nil, nil, nil
+	}
+
+	var extra map[string]any
+	var order, warnings []string
+	mapping := doc.Content[0]
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := mapping.Content[i].Value
+		if knownFrontmatterKeys[key] {
+			valid, lenient := lenientFields[key]
+			if !lenient || valid(mapping.Content[i+1]) {
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("%s: unsupported value kept as written", key))
+		}
+		var value any
+		if err := mapping.Content[i+1].Decode(&value); err != nil {
+			return nil, nil, nil, err
+		}
+		if extra == nil {
+			extra = make(map[string]any)
+		}
+		if _, exists := extra[key]; !exists {
+			order = append(order, key)
+		}
+		extra[key] = value
+	}
+
+	return extra, order, warnings, nil
+}
+
+// parsePoints converts a points value to a whole number. Quoted numbers such
+// as "3" are accepted. ok is false for values like 0.5.
+func parsePoints(node *yaml.Node) (points int, ok bool) {
+	if node.Kind == 0 || node.Tag == "!!null" {
+		return 0, true
+	}
+	if node.Kind != yaml.ScalarNode {
+		return 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(node.Value))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// parseIssueNumbers converts a link value to issue numbers. A list or a
+// single value is accepted, each as N or "#N". ok is false for anything
+// else, such as [a].
+func parseIssueNumbers(node *yaml.Node) (numbers []int, ok bool) {
+	items := []*yaml.Node{node}
+	switch {
+	case node.Kind == 0 || node.Tag == "!!null":
+		return nil, true
+	case node.Kind == yaml.SequenceNode:
+		items = node.Content
+	case node.Kind != yaml.ScalarNode:
+		return nil, false
+	}
+	for _, item := range items {
+		if item.Kind != yaml.ScalarNode {
+			return nil, false
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(item.Value), "#"))
+		if err != nil {
+			return nil, false
+		}
+		numbers = append(numbers, n)
+	}
+	return numbers, true
+}
+
+// parseAttachments decodes the attachments list. A single string is taken
+// as one attachment with that URL. ok is false for any other shape.
+func parseAttachments(node *yaml.Node) (attachments []Attachment, ok bool) {
+	switch {
+	case node.Kind == 0 || node.Tag == "!!null":
+		return nil, true
+	case node.Kind == yaml.ScalarNode:
+		return []Attachment{{URL: node.Value}}, true
+	case node.Kind != yaml.SequenceNode:
+		return nil, false
+	}
+	if err := node.Decode(&attachments); err != nil {
+		return nil, false
+	}
+	return attachments, true
+}
+
+func isIssueNumbers(node *yaml.Node) bool {
+	_, ok := parseIssueNumbers(node)
+	return ok
+}
+
+// normalizeState maps a state alias to its state so the file is written
+// back with the canonical value. Unknown states are kept as is for
+// Validate and fix-state to report.
+func normalizeState(s State) State {
+	if state, ok := ParseState(string(s)); ok {
+		return state
+	}
+	return s
 }
 
 // parseFlexibleTime parses time from various formats
@@ -160,15 +330,41 @@ func ParseBytes(data []byte, filePath string) (*Issue, error) {
 		return nil, fmt.Errorf("failed to unmarshal frontmatter: %w", err)
 	}
 
+	extra, extraOrder, warnings, err := parseExtraFields(frontmatter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal frontmatter: %w", err)
+	}
+
+	// Rejected values are in extra; the fields stay empty
+	points, _ := parsePoints(&raw.Points)
+	numbers := func(node *yaml.Node) []int {
+		n, _ := parseIssueNumbers(node)
+		return n
+	}
+	attachments, _ := parseAttachments(&raw.Attachments)
+
 	// Convert to Issue struct
 	issue := Issue{
 		Number:    raw.Number,
 		Title:     raw.Title,
-		State:     raw.State,
+		State:     normalizeState(raw.State),
 		Labels:    raw.Labels,
 		Assignees: raw.Assignees,
+		Points:    points,
 		Body:      body,
 		FilePath:  filePath,
+
+		RelatesTo:    numbers(&raw.RelatesTo),
+		Duplicates:   numbers(&raw.Duplicates),
+		DuplicatedBy: numbers(&raw.DuplicatedBy),
+		Blocks:       numbers(&raw.Blocks),
+		BlockedBy:    numbers(&raw.BlockedBy),
+
+		Attachments: attachments,
+
+		Extra:         extra,
+		extraOrder:    extraOrder,
+		ParseWarnings: warnings,
 	}
 
 	// Parse created time (prefer created_at, fallback to created)
@@ -194,6 +390,15 @@ func ParseBytes(data []byte, filePath string) (*Issue, error) {
 		}
 	}
 
+	// Parse state history (entries with unparsable timestamps are skipped)
+	for _, entry := range raw.StateHistory {
+		t, err := parseFlexibleTime(entry.At)
+		if err != nil || entry.State == "" {
+			continue
+		}
+		issue.StateHistory = append(issue.StateHistory, StateChange{State: normalizeState(entry.State), At: t})
+	}
+
 	return &issue, nil
 }
 
@@ -244,9 +449,72 @@ type serializableFrontmatter struct {
 	State     State    `yaml:"state"`
 	Labels    []string `yaml:"labels"`
 	Assignees []string `yaml:"assignees"`
+	Points    int      `yaml:"points,omitempty"`
 	CreatedAt string   `yaml:"created_at"`
 	UpdatedAt string   `yaml:"updated_at"`
 	ClosedAt  string   `yaml:"closed_at,omitempty"`
+
+	RelatesTo    []int `yaml:"relates_to,omitempty,flow"`
+	Duplicates   []int `yaml:"duplicates,omitempty,flow"`
+	DuplicatedBy []int `yaml:"duplicated_by,omitempty,flow"`
+	Blocks       []int `yaml:"blocks,omitempty,flow"`
+	BlockedBy    []int `yaml:"blocked_by,omitempty,flow"`
+
+	Attachments []Attachment `yaml:"attachments,omitempty"`
+
+	StateHistory []serializableStateChange `yaml:"state_history,omitempty"`
+}
+
+// serializableStateChange is a state_history entry with an RFC3339 UTC timestamp
+type serializableStateChange struct {
+	State State  `yaml:"state"`
+	At    string `yaml:"at"`
+}
+
+// extraKeys returns Extra keys in their original order, followed by any
+// keys added programmatically in sorted order
+func (i *Issue) extraKeys() []string {
+	seen := make(map[string]bool, len(i.extraOrder))
+	var keys []string
+	for _, key := range i.extraOrder {
+		if _, ok := i.Extra[key]; ok && !seen[key] && i.isExtraKey(key) {
+			keys = append(keys, key)
+			seen[key] = true
+		}
+	}
+
+	var added []string
+	for key := range i.Extra {
+		if !seen[key] && i.isExtraKey(key) {
+			added = append(added, key)
+		}
+	}
+	sort.Strings(added)
+
+	return append(keys, added...)
+}
+
+// isExtraKey reports whether Extra[key] is written out. Known keys are
+// written from their fields, except a value lenientFields rejected, which
+// stays in Extra until its field is set.
+func (i *Issue) isExtraKey(key string) bool {
+	switch key {
+	case "points":
+		return i.Points == 0
+	case "relates_to":
+		return len(i.RelatesTo) == 0
+	case "duplicates":
+		return len(i.Duplicates) == 0
+	case "duplicated_by":
+		return len(i.DuplicatedBy) == 0
+	case "blocks":
+		return len(i.Blocks) == 0
+	case "blocked_by":
+		return len(i.BlockedBy) == 0
+	case "attachments":
+		return len(i.Attachments) == 0
+	}
+	return !knownFrontmatterKeys[key]
 }
 
 // Serialize converts an Issue back to markdown format
@@ -258,15 +526,45 @@ func Serialize(issue *Issue) ([]byte, error) {
 		State:     issue.State,
 		Labels:    issue.Labels,
 		Assignees: issue.Assignees,
+		Points:    issue.Points,
 		CreatedAt: issue.CreatedAt.UTC().Format(time.RFC3339),
 		UpdatedAt: issue.UpdatedAt.UTC().Format(time.RFC3339),
+
+		RelatesTo:    issue.RelatesTo,
+		Duplicates:   issue.Duplicates,
+		DuplicatedBy: issue.DuplicatedBy,
+		Blocks:       issue.Blocks,
+		BlockedBy:    issue.BlockedBy,
+
+		Attachments: issue.Attachments,
 	}
 
 	if issue.ClosedAt != nil {
 		sf.ClosedAt = issue.ClosedAt.UTC().Format(time.RFC3339)
 	}
 
-	frontmatter, err := yaml.Marshal(sf)
+	for _, entry := range issue.StateHistory {
+		sf.StateHistory = append(sf.StateHistory, serializableStateChange{
+			State: entry.State,
+			At:    entry.At.UTC().Format(time.RFC3339),
+		})
+	}
+
+	var node yaml.Node
+	if err := node.Encode(sf); err != nil {
+		return nil, fmt.Errorf("failed to marshal frontmatter: %w", err)
+	}
+
+	// Append custom fields after the known ones
+	for _, key := range issue.extraKeys() {
+		var value yaml.Node
+		if err := value.Encode(issue.Extra[key]); err != nil {
+			return nil, fmt.Errorf("failed to marshal frontmatter field %s: %w", key, err)
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, &value)
+	}
+
+	frontmatter, err := yaml.Marshal(&node)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal frontmatter: %w", err)
 	}
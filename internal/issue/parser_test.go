@@ -1,6 +1,7 @@
 package issue
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -144,6 +145,199 @@ func TestSerialize(t *testing.T) {
 	}
 }
 
+func TestStateHistoryRoundTrip(t *testing.T) {
+	issue := &Issue{
+		Number:    1,
+		Title:     "Test",
+		State:     StateWip,
+		CreatedAt: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC),
+		StateHistory: []StateChange{
+			{State: StateWip, At: time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	data, err := Serialize(issue)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+
+	parsed, err := ParseBytes(data, "test.md")
+	if err != nil {
+		t.Fatalf("ParseBytes failed: %v", err)
+	}
+
+	if len(parsed.StateHistory) != 1 {
+		t.Fatalf("StateHistory length = %d, want 1", len(parsed.StateHistory))
+	}
+	if parsed.StateHistory[0].State != StateWip || !parsed.StateHistory[0].At.Equal(issue.StateHistory[0].At) {
+		t.Errorf("StateHistory[0] = %+v, want %+v", parsed.StateHistory[0], issue.StateHistory[0])
+	}
+
+	// Empty history must not be serialized
+	issue.StateHistory = nil
+	data, err = Serialize(issue)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	if containsString(string(data), "state_history") {
+		t.Errorf("Expected no state_history field, got:\n%s", data)
+	}
+}
+
+func TestParseBytesStateAlias(t *testing.T) {
+	content := `---
+number: 1
+title: Test
+state: in-progress
+state_history:
+  - state: cancelled
+    at: 2026-01-16T00:00:00Z
+created_at: 2026-01-15T00:00:00Z
+updated_at: 2026-01-16T00:00:00Z
+---
+`
+	issue, err := ParseBytes([]byte(content), "test.md")
+	if err != nil {
+		t.Fatalf("ParseBytes failed: %v", err)
+	}
+	if issue.State != StateWip {
+		t.Errorf("State = %q, want %q", issue.State, StateWip)
+	}
+	if len(issue.StateHistory) != 1 || issue.StateHistory[0].State != StateClosed {
+		t.Errorf("StateHistory = %+v, want one closed entry", issue.StateHistory)
+	}
+
+	data, err := Serialize(issue)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	if !containsString(string(data), "state: wip") || containsString(string(data), "in-progress") {
+		t.Errorf("alias not normalized on write:\n%s", data)
+	}
+}
+
+func TestPointsRoundTrip(t *testing.T) {
+	issue := &Issue{
+		Number:    1,
+		Title:     "Test",
+		State:     StateOpen,
+		Points:    5,
+		CreatedAt: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	data, err := Serialize(issue)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	parsed, err := ParseBytes(data, "test.md")
+	if err != nil {
+		t.Fatalf("ParseBytes failed: %v", err)
+	}
+	if parsed.Points != 5 {
+		t.Errorf("Points = %d, want 5", parsed.Points)
+	}
+	if len(parsed.Extra) != 0 {
+		t.Errorf("points must not end up in Extra: %v", parsed.Extra)
+	}
+
+	// Unestimated issues must not get a points field
+	issue.Points = 0
+	data, err = Serialize(issue)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	if containsString(string(data), "points") {
+		t.Errorf("Expected no points field, got:\n%s", data)
+	}
+}
+
+func TestParsePoints(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       string
+		wantPoints int
+		wantExtra  any
+	}{
+		{"number", "points: 3", 3, nil},
+		{"quoted number", `points: "3"`, 3, nil},
+		{"empty", "points:", 0, nil},
+		{"fraction", "points: 0.5", 0, 0.5},
+		{"text", "points: large", 0, "large"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "---\nnumber: 1\ntitle: Test\nstate: open\n" + tt.line + "\ncreated_at: 2026-01-15T00:00:00Z\nupdated_at: 2026-01-15T00:00:00Z\n---\n"
+			issue, err := ParseBytes([]byte(content), "test.md")
+			if err != nil {
+				t.Fatalf("ParseBytes failed: %v", err)
+			}
+			if issue.Points != tt.wantPoints {
+				t.Errorf("Points = %d, want %d", issue.Points, tt.wantPoints)
+			}
+			if issue.Extra["points"] != tt.wantExtra {
+				t.Errorf("Extra[points] = %v, want %v", issue.Extra["points"], tt.wantExtra)
+			}
+
+			// A value that is not a whole number survives a rewrite
+			data, err := Serialize(issue)
+			if err != nil {
+				t.Fatalf("Serialize failed: %v", err)
+			}
+			if tt.wantExtra != nil && !containsString(string(data), tt.line) {
+				t.Errorf("points lost on write:\n%s", data)
+			}
+		})
+	}
+}
+
+func TestExtraFieldsRoundTrip(t *testing.T) {
+	content := `---
+number: 1
+title: Test
+state: open
+epic: auth
+labels: []
+assignees: []
+external_id: 1234
+created_at: 2026-01-15T00:00:00Z
+updated_at: 2026-01-15T00:00:00Z
+---
+
+Body.
+`
+
+	issue, err := ParseBytes([]byte(content), "test.md")
+	if err != nil {
+		t.Fatalf("ParseBytes failed: %v", err)
+	}
+
+	if issue.Extra["epic"] != "auth" || issue.Extra["external_id"] != 1234 {
+		t.Errorf("Extra = %v, want epic and external_id", issue.Extra)
+	}
+	if _, ok := issue.Extra["title"]; ok {
+		t.Error("Known fields must not be stored in Extra")
+	}
+
+	data, err := Serialize(issue)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+
+	out := string(data)
+	epicIdx := strings.Index(out, "epic: auth")
+	extIdx := strings.Index(out, "external_id: 1234")
+	updatedIdx := strings.Index(out, "updated_at:")
+	if epicIdx == -1 || extIdx == -1 {
+		t.Fatalf("Extra fields missing from output:\n%s", out)
+	}
+	if epicIdx < updatedIdx || extIdx < epicIdx {
+		t.Errorf("Extra fields should follow known fields in original order:\n%s", out)
+	}
+}
+
 func containsString(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsStringHelper(s, substr))
 }
@@ -1,16 +1,29 @@
 package issue
 
 import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
+	"strings"
 )
 
 var refPattern = regexp.MustCompile(`#(\d+)`)
 
+var (
+	// fencePattern matches the opening or closing line of a fenced code block
+	fencePattern = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+	// urlPattern matches URLs, whose fragments (http://host/#123) aren't references
+	urlPattern = regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^\s<>()]+`)
+)
+
 // ExtractRefs extracts issue references (#N) from text.
+// References inside code and URLs are ignored (see RefText).
 // Returns unique issue numbers in ascending order.
 func ExtractRefs(text string) []int {
-	matches := refPattern.FindAllStringSubmatch(text, -1)
+	matches := refPattern.FindAllStringSubmatch(RefText(text), -1)
 	if len(matches) == 0 {
 		return nil
 	}
@@ -34,6 +47,89 @@ func ExtractRefs(text string) []int {
 	return refs
 }
 
+// RefText returns markdown text with fenced code blocks, inline code spans
+// and URLs blanked out, so that a #N inside them is not taken for an issue
+// reference. Line breaks are kept.
+func RefText(text string) string {
+	var b strings.Builder
+	fence := ""
+	for _, line := range strings.SplitAfter(text, "\n") {
+		content := strings.TrimRight(line, "\r\n")
+		eol := line[len(content):]
+
+		if fence != "" {
+			// A closing fence uses the same character, at least as many times
+			m := fencePattern.FindStringSubmatch(content)
+			if m != nil && m[1][0] == fence[0] && len(m[1]) >= len(fence) &&
+				strings.TrimSpace(content[len(m[0]):]) == "" {
+				fence = ""
+			}
+			b.WriteString(eol)
+			continue
+		}
+		if m := fencePattern.FindStringSubmatch(content); m != nil {
+			fence = m[1]
+			b.WriteString(eol)
+			continue
+		}
+
+		b.WriteString(urlPattern.ReplaceAllString(stripCodeSpans(content), " "))
+		b.WriteString(eol)
+	}
+	return b.String()
+}
+
+// stripCodeSpans replaces `code` spans in a line with a space. A span is
+// closed by a backtick run of the same length; unclosed backticks are kept.
+func stripCodeSpans(line string) string {
+	if !strings.Contains(line, "`") {
+		return line
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(line); {
+		if line[i] != '`' {
+			b.WriteByte(line[i])
+			i++
+			continue
+		}
+
+		run := 0
+		for i+run < len(line) && line[i+run] == '`' {
+			run++
+		}
+		end := closingBackticks(line, i+run, run)
+		if end < 0 {
+			b.WriteString(line[i : i+run])
+			i += run
+			continue
+		}
+		b.WriteByte(' ')
+		i = end + run
+	}
+	return b.String()
+}
+
+// closingBackticks returns the index of the next run of exactly n backticks
+// at or after start, or -1.
+func closingBackticks(line string, start, n int) int {
+	for i := start; i < len(line); {
+		if line[i] != '`' {
+			i++
+			continue
+		}
+		run := 0
+		for i+run < len(line) && line[i+run] == '`' {
+			run++
+		}
+		if run == n {
+			return i
+		}
+		i += run
+	}
+	return -1
+}
+
 // RefGraph represents the reference relationships between issues.
 type RefGraph struct {
 	// Mentions maps issue number -> issue numbers it mentions
@@ -55,7 +151,14 @@ func NewRefGraph() *RefGraph {
 
 // BuildRefGraph builds a reference graph from all issues in the store.
 // Only includes references to issues that actually exist.
+// The graph is cached on the store and rebuilt only when issue files change,
+// so callers must not modify it.
 func (s *Store) BuildRefGraph() (*RefGraph, error) {
+	stamp := s.filesStamp()
+	if s.refGraph != nil && stamp == s.refGraphStamp {
+		return s.refGraph, nil
+	}
+
 	issues, err := s.List()
 	if err != nil {
 		return nil, err
@@ -89,9 +192,39 @@ func (s *Store) BuildRefGraph() (*RefGraph, error) {
 		}
 	}
 
+	s.refGraph = graph
+	s.refGraphStamp = stamp
 	return graph, nil
 }
 
+// filesStamp fingerprints the name, size and modification time of every
+// issue file, which is much cheaper than parsing them.
+func (s *Store) filesStamp() string {
+	dirs := []string{s.baseDir}
+	for _, state := range AllStates() {
+		dirs = append(dirs, filepath.Join(s.baseDir, StateDir(state)))
+	}
+
+	h := fnv.New64a()
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(h, "%s/%s %d %d\n", dir, entry.Name(), info.Size(), info.ModTime().UnixNano())
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
 // RefDirection represents the direction of a reference.
 type RefDirection string
 
@@ -211,7 +344,8 @@ type TreeNode struct {
 }
 
 // BuildTree builds a tree structure from connected issues for display.
-// This groups issues by their parent relationship.
+// This groups issues by their parent relationship. Each issue appears at
+// most once, so mutual references cannot recurse forever.
 func (g *RefGraph) BuildTree(issueNum int) []*TreeNode {
 	connected := g.GetConnectedIssues(issueNum)
 	if len(connected) == 0 {
@@ -225,6 +359,7 @@ func (g *RefGraph) BuildTree(issueNum int) []*TreeNode {
 	}
 
 	// Build tree recursively
+	visited := map[int]bool{issueNum: true}
 	var buildChildren func(parent int) []*TreeNode
 	buildChildren = func(parent int) []*TreeNode {
 		children := childrenOf[parent]
@@ -234,6 +369,10 @@ func (g *RefGraph) BuildTree(issueNum int) []*TreeNode {
 
 		nodes := make([]*TreeNode, 0, len(children))
 		for _, c := range children {
+			if visited[c.Number] {
+				continue
+			}
+			visited[c.Number] = true
 			node := &TreeNode{
 				Issue:     c.Issue,
 				Direction: c.Direction,
@@ -1,6 +1,8 @@
 package issue
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 )
@@ -66,6 +68,46 @@ func TestExtractRefs(t *testing.T) {
 			text:     "Issue #0 should be ignored",
 			expected: nil,
 		},
+		{
+			name:     "fenced code block ignored",
+			text:     "See #1\n\n```go\n// #123 is not a ref\n```\n\nAnd #2",
+			expected: []int{1, 2},
+		},
+		{
+			name:     "tilde fence with longer closing fence",
+			text:     "~~~\n#123\n~~~~\n#3",
+			expected: []int{3},
+		},
+		{
+			name:     "backtick fence not closed by tildes",
+			text:     "```\n#123\n~~~\n#124\n```\n#4",
+			expected: []int{4},
+		},
+		{
+			name:     "unclosed fence hides the rest",
+			text:     "#5\n```\n#123",
+			expected: []int{5},
+		},
+		{
+			name:     "inline code ignored",
+			text:     "Run `zap show #123` or ``a ` #124`` then see #6",
+			expected: []int{6},
+		},
+		{
+			name:     "unmatched backtick kept",
+			text:     "it`s #7",
+			expected: []int{7},
+		},
+		{
+			name:     "URL fragment ignored",
+			text:     "See http://host/page#123 and https://example.com/#124, not #8",
+			expected: []int{8},
+		},
+		{
+			name:     "reference after URL",
+			text:     "(https://example.com/a) #9",
+			expected: []int{9},
+		},
 	}
 
 	for _, tt := range tests {
@@ -256,3 +298,56 @@ func filterByDistance(connected []ConnectedIssue, distance int) []ConnectedIssue
 	}
 	return result
 }
+
+func TestBuildRefGraphCache(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("001-a.md", "---\nnumber: 1\ntitle: A\nstate: open\n---\nSee #2\n")
+	write("002-b.md", "---\nnumber: 2\ntitle: B\nstate: open\n---\n")
+
+	store := NewStore(dir)
+	first, err := store.BuildRefGraph()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := store.BuildRefGraph()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Error("expected cached graph when files are unchanged")
+	}
+
+	// Changing a file invalidates the cache
+	write("002-b.md", "---\nnumber: 2\ntitle: B\nstate: open\n---\nBack to #1\n")
+	third, err := store.BuildRefGraph()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third == first {
+		t.Fatal("expected a rebuilt graph after a file changed")
+	}
+	if got := third.Mentions[2]; len(got) != 1 || got[0] != 1 {
+		t.Errorf("Mentions[2] = %v, want [1]", got)
+	}
+}
+
+func TestRefGraph_BuildTree_Mutual(t *testing.T) {
+	// #1 <-> #2 mention each other
+	graph := NewRefGraph()
+	graph.Issues[1] = &Issue{Number: 1}
+	graph.Issues[2] = &Issue{Number: 2}
+	graph.Mentions[1] = []int{2}
+	graph.Mentions[2] = []int{1}
+	graph.MentionedBy[1] = []int{2}
+	graph.MentionedBy[2] = []int{1}
+
+	tree := graph.BuildTree(1)
+	if len(tree) != 1 || len(tree[0].Children) != 0 {
+		t.Errorf("expected a single leaf for mutual references, got %d nodes", len(tree))
+	}
+}
@@ -0,0 +1,137 @@
+package issue
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// StateDef describes one issue state.
+type StateDef struct {
+	Name State `yaml:"name"`
+
+	// Tag is shown before the title in lists (default "[name]")
+	Tag string `yaml:"tag,omitempty"`
+
+	// Color is a color name or 256-color number; empty keeps the default look
+	Color string `yaml:"color,omitempty"`
+
+	// Active states are listed by default and count as work not yet finished
+	Active bool `yaml:"active,omitempty"`
+
+	// Terminal states finish an issue and set its closed_at
+	Terminal bool `yaml:"terminal,omitempty"`
+}
+
+// Label returns the list tag of the state.
+func (d StateDef) Label() string {
+	if d.Tag != "" {
+		return d.Tag
+	}
+	return "[" + string(d.Name) + "]"
+}
+
+// StateSet is the set of states issues can be in, in display order.
+type StateSet []StateDef
+
+// DefaultStates returns the built-in states: open and wip are active, done
+// and closed are terminal.
+func DefaultStates() StateSet {
+	return StateSet{
+		{Name: StateOpen, Active: true},
+		{Name: StateWip, Active: true},
+		{Name: StateDone, Terminal: true},
+		{Name: StateClosed, Terminal: true},
+	}
+}
+
+// stateNamePattern keeps state names usable as command arguments and as
+// directory names in the legacy layout.
+var stateNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// Validate checks that names are valid and unique, that no state is both
+// active and terminal, and that the built-in states are present, since
+// commands such as new and set --claim move issues into them.
+func (set StateSet) Validate() error {
+	seen := make(map[State]bool)
+	for _, d := range set {
+		if !stateNamePattern.MatchString(string(d.Name)) {
+			return fmt.Errorf("invalid state name %q (use lowercase letters, digits, - and _)", d.Name)
+		}
+		if seen[d.Name] {
+			return fmt.Errorf("state %q is defined twice", d.Name)
+		}
+		seen[d.Name] = true
+		if d.Active && d.Terminal {
+			return fmt.Errorf("state %q cannot be both active and terminal", d.Name)
+		}
+	}
+	for _, d := range DefaultStates() {
+		if !seen[d.Name] {
+			return fmt.Errorf("built-in state %q is missing", d.Name)
+		}
+	}
+	return nil
+}
+
+// Lookup returns the definition of a state.
+func (set StateSet) Lookup(s State) (StateDef, bool) {
+	for _, d := range set {
+		if d.Name == s {
+			return d, true
+		}
+	}
+	return StateDef{}, false
+}
+
+// Parse converts a string to a state of the set. Aliases such as
+// "in-progress" are accepted and mapped to their state.
+func (set StateSet) Parse(s string) (State, bool) {
+	if _, ok := set.Lookup(State(s)); ok {
+		return State(s), true
+	}
+	state, ok := stateAliases[s]
+	return state, ok
+}
+
+// Names returns the states of the set in display order.
+func (set StateSet) Names() []State {
+	names := make([]State, len(set))
+	for i, d := range set {
+		names[i] = d.Name
+	}
+	return names
+}
+
+// filter returns the names of the states for which keep is true.
+func (set StateSet) filter(keep func(StateDef) bool) []State {
+	var names []State
+	for _, d := range set {
+		if keep(d) {
+			names = append(names, d.Name)
+		}
+	}
+	return names
+}
+
+// states is the state set in use (see SetStates)
+var states = DefaultStates()
+
+// SetStates replaces the state set in use, normally with the states section
+// of .zap.yml. An empty set restores the built-in states. The set is
+// expected to be validated.
+func SetStates(set StateSet) {
+	if len(set) == 0 {
+		set = DefaultStates()
+	}
+	states = set
+}
+
+// States returns the state set in use.
+func States() StateSet {
+	return append(StateSet(nil), states...)
+}
+
+// LookupState returns the definition of a state in the set in use.
+func LookupState(s State) (StateDef, bool) {
+	return states.Lookup(s)
+}
@@ -0,0 +1,82 @@
+package issue
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestStateSetValidate(t *testing.T) {
+	withReview := append(DefaultStates(), StateDef{Name: "review", Active: true})
+
+	tests := []struct {
+		name    string
+		set     StateSet
+		wantErr bool
+	}{
+		{"defaults", DefaultStates(), false},
+		{"extra state", withReview, false},
+		{"missing built-in", DefaultStates()[:3], true},
+		{"duplicate", append(DefaultStates(), StateDef{Name: StateWip}), true},
+		{"invalid name", append(DefaultStates(), StateDef{Name: "In Review"}), true},
+		{"active and terminal", append(DefaultStates(), StateDef{Name: "shipped", Active: true, Terminal: true}), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.set.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetStates(t *testing.T) {
+	t.Cleanup(func() { SetStates(nil) })
+	SetStates(StateSet{
+		{Name: StateOpen, Active: true},
+		{Name: StateWip, Active: true},
+		{Name: "review", Tag: "[rev]", Active: true},
+		{Name: "blocked"},
+		{Name: StateDone, Terminal: true},
+		{Name: "shipped", Terminal: true},
+		{Name: StateClosed, Terminal: true},
+	})
+
+	if got := AllStates(); len(got) != 7 || got[2] != "review" {
+		t.Errorf("AllStates() = %v", got)
+	}
+	if got, want := ActiveStates(), []State{StateOpen, StateWip, "review"}; !slices.Equal(got, want) {
+		t.Errorf("ActiveStates() = %v, want %v", got, want)
+	}
+	if got, want := TerminalStates(), []State{StateDone, "shipped", StateClosed}; !slices.Equal(got, want) {
+		t.Errorf("TerminalStates() = %v, want %v", got, want)
+	}
+	if s, ok := ParseState("review"); !ok || s != "review" {
+		t.Errorf("ParseState(review) = %q, %v", s, ok)
+	}
+	if s, ok := ParseState("in-progress"); !ok || s != StateWip {
+		t.Errorf("ParseState(in-progress) = %q, %v", s, ok)
+	}
+	if def, _ := LookupState("review"); def.Label() != "[rev]" {
+		t.Errorf("Label() = %q, want [rev]", def.Label())
+	}
+
+	iss := &Issue{Number: 1, Title: "Ship it", State: "blocked"}
+	if iss.IsActive() {
+		t.Error("blocked should not be active")
+	}
+	now := time.Now()
+	iss.SetState("shipped", now)
+	if iss.ClosedAt == nil {
+		t.Error("closed_at should be set for a terminal state")
+	}
+	if err := iss.Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+
+	SetStates(nil)
+	if _, ok := ParseState("review"); ok {
+		t.Error("review should be unknown after restoring the defaults")
+	}
+}
@@ -22,6 +22,12 @@ type ParseFailure struct {
 type Store struct {
 	baseDir  string
 	warnings []ParseFailure // Collected during List operations
+	workflow *Workflow      // Optional transition policy (nil = allow all)
+
+	watchDebounce time.Duration // Quiet period for Watch (0 = DefaultWatchDebounce)
+
+	refGraph      *RefGraph // Cached result of BuildRefGraph
+	refGraphStamp string    // Issue files fingerprint the cached graph was built from
 }
 
 // NewStore creates a new Store
@@ -34,6 +40,12 @@ func (s *Store) BaseDir() string {
 	return s.baseDir
 }
 
+// SetWorkflow sets the transition policy enforced by Move and UpdateState.
+// Pass nil to allow all transitions.
+func (s *Store) SetWorkflow(w *Workflow) {
+	s.workflow = w
+}
+
 // Warnings returns parse failures from the last List operation.
 func (s *Store) Warnings() []ParseFailure {
 	return s.warnings
@@ -137,6 +149,95 @@ func (s *Store) List(states ...State) ([]*Issue, error) {
 	return issues, nil
 }
 
+// ListMatching returns the issues in states for which match returns true (all
+// of them if match is nil), reading files in name order, which is issue
+// number order for NNN-title.md files. Non-matching issues are dropped as
+// they are parsed instead of being collected first. Unlike List, the result
+// keeps file order and is not sorted by updated_at.
+// Call Warnings() afterwards for the parse failures seen during the scan.
+//
+// The layout is chosen like List: the flat base directory is used when at
+// least one issue file in it parses, the legacy state directories otherwise.
+func (s *Store) ListMatching(match func(*Issue) bool, states ...State) ([]*Issue, error) {
+	if len(states) == 0 {
+		states = AllStates()
+	}
+	s.warnings = nil
+
+	stateFilter := make(map[State]bool)
+	for _, state := range states {
+		stateFilter[state] = true
+	}
+
+	var results []*Issue
+	flatParsed := false
+	// visit parses one file. dirState is the state directory of a legacy
+	// file, empty for flat files.
+	visit := func(dir, name string, dirState State) {
+		filePath := filepath.Join(dir, name)
+		issue, err := Parse(filePath)
+		if err != nil {
+			s.warnings = append(s.warnings, ParseFailure{
+				FilePath: filePath,
+				FileName: name,
+				Error:    err.Error(),
+				State:    dirState,
+			})
+			return
+		}
+		if dirState != "" {
+			issue.State = dirState
+		} else {
+			flatParsed = true
+		}
+		if stateFilter[issue.State] && (match == nil || match(issue)) {
+			results = append(results, issue)
+		}
+	}
+
+	// Flat structure when an issue file in the base directory parses
+	if names, err := markdownFileNames(s.baseDir); err == nil {
+		for _, name := range names {
+			visit(s.baseDir, name, "")
+		}
+		if flatParsed {
+			return results, nil
+		}
+		// Like List, only the legacy failures are reported after a fallback
+		s.warnings = nil
+	}
+
+	for _, state := range states {
+		dir := filepath.Join(s.baseDir, StateDir(state))
+		names, err := markdownFileNames(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, name := range names {
+			visit(dir, name, state)
+		}
+	}
+	return results, nil
+}
+
+// markdownFileNames returns the sorted names of the .md files in dir.
+func markdownFileNames(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".md") {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
 // loadFromDir loads all issues from a legacy directory, returning both successful parses and failures.
 // This is used for backward compatibility with directory-based state management.
 func (s *Store) loadFromDir(dir string, state State) ([]*Issue, []ParseFailure, error) {
@@ -239,6 +340,10 @@ func (s *Store) Move(number int, newState State) error {
 		return nil // 이미 같은 상태
 	}
 
+	if err := s.workflow.Check(issue.State, newState); err != nil {
+		return err
+	}
+
 	// Check if using flat structure (file is directly in baseDir)
 	if filepath.Dir(issue.FilePath) == s.baseDir {
 		// Flat structure: update frontmatter
@@ -270,16 +375,13 @@ func (s *Store) UpdateState(issue *Issue, newState State) error {
 		return nil
 	}
 
-	// Update state and timestamps
-	issue.State = newState
-	issue.UpdatedAt = time.Now().UTC()
+	if err := s.workflow.Check(issue.State, newState); err != nil {
+		return err
+	}
 
-	// Handle closed_at timestamp
-	if newState == StateDone || newState == StateClosed {
-		now := time.Now().UTC()
-		issue.ClosedAt = &now
-	} else {
-		issue.ClosedAt = nil
+	issue.SetState(newState, time.Now().UTC())
+	if err := issue.Validate(); err != nil {
+		return err
 	}
 
 	// Serialize and write back
@@ -295,6 +397,43 @@ func (s *Store) UpdateState(issue *Issue, newState State) error {
 	return nil
 }
 
+// Save validates the issue and writes it back to its file.
+func (s *Store) Save(issue *Issue) error {
+	if err := issue.Validate(); err != nil {
+		return err
+	}
+
+	data, err := Serialize(issue)
+	if err != nil {
+		return fmt.Errorf("failed to serialize issue: %w", err)
+	}
+
+	if err := os.WriteFile(issue.FilePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write issue file: %w", err)
+	}
+
+	return nil
+}
+
+// PreviewState returns the content UpdateState would write for the issue,
+// without modifying the issue or its file. The workflow policy is enforced.
+func (s *Store) PreviewState(issue *Issue, newState State) ([]byte, error) {
+	if err := s.workflow.Check(issue.State, newState); err != nil {
+		return nil, err
+	}
+
+	preview := *issue
+	preview.StateHistory = append([]StateChange(nil), issue.StateHistory...)
+	if issue.State != newState {
+		preview.SetState(newState, time.Now().UTC())
+	}
+	if err := preview.Validate(); err != nil {
+		return nil, err
+	}
+
+	return Serialize(&preview)
+}
+
 // Search searches issues by keyword in title and body
 func (s *Store) Search(keyword string, titleOnly bool) ([]*Issue, error) {
 	issues, err := s.List()
@@ -321,10 +460,38 @@ func (s *Store) Search(keyword string, titleOnly bool) ([]*Issue, error) {
 
 // Stats returns statistics about issues
 type Stats struct {
-	Total      int
-	ByState    map[State]int
-	ByLabel    map[string]int
-	ByAssignee map[string]int
+	Total         int
+	ByState       map[State]int
+	ByLabel       map[string]int
+	ByAssignee    map[string]int
+	PointsByState map[State]int // sum of estimate points per state
+}
+
+// NewStats returns empty statistics.
+func NewStats() *Stats {
+	return &Stats{
+		ByState:       make(map[State]int),
+		ByLabel:       make(map[string]int),
+		ByAssignee:    make(map[string]int),
+		PointsByState: make(map[State]int),
+	}
+}
+
+// Merge adds the counts of other to s.
+func (s *Stats) Merge(other *Stats) {
+	s.Total += other.Total
+	for state, n := range other.ByState {
+		s.ByState[state] += n
+	}
+	for label, n := range other.ByLabel {
+		s.ByLabel[label] += n
+	}
+	for assignee, n := range other.ByAssignee {
+		s.ByAssignee[assignee] += n
+	}
+	for state, n := range other.PointsByState {
+		s.PointsByState[state] += n
+	}
 }
 
 // Stats returns statistics about issues
@@ -334,15 +501,12 @@ func (s *Store) Stats() (*Stats, error) {
 		return nil, err
 	}
 
-	stats := &Stats{
-		Total:      len(issues),
-		ByState:    make(map[State]int),
-		ByLabel:    make(map[string]int),
-		ByAssignee: make(map[string]int),
-	}
+	stats := NewStats()
+	stats.Total = len(issues)
 
 	for _, issue := range issues {
 		stats.ByState[issue.State]++
+		stats.PointsByState[issue.State] += issue.Points
 
 		for _, label := range issue.Labels {
 			stats.ByLabel[label]++
@@ -356,6 +520,22 @@ func (s *Store) Stats() (*Stats, error) {
 	return stats, nil
 }
 
+// CommittedPoints returns points of all issues that weren't cancelled.
+func (s *Stats) CommittedPoints() int {
+	total := 0
+	for state, points := range s.PointsByState {
+		if state != StateClosed {
+			total += points
+		}
+	}
+	return total
+}
+
+// CompletedPoints returns points of done issues.
+func (s *Stats) CompletedPoints() int {
+	return s.PointsByState[StateDone]
+}
+
 // FilterByLabel returns issues with a specific label
 func (s *Store) FilterByLabel(label string, states ...State) ([]*Issue, error) {
 	issues, err := s.List(states...)
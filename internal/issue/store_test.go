@@ -1,8 +1,10 @@
 package issue
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 )
 
@@ -206,6 +208,65 @@ Body content.
 	}
 }
 
+func TestListMatching(t *testing.T) {
+	dir := t.TempDir()
+	files := []struct {
+		name, state, labels string
+	}{
+		{"001-a.md", "open", "[bug]"},
+		{"002-b.md", "open", "[]"},
+		{"003-c.md", "done", "[bug]"},
+		{"004-d.md", "wip", "[bug]"},
+		{"005-e.md", "open", "[bug]"},
+	}
+	for i, f := range files {
+		content := fmt.Sprintf("---\nnumber: %d\ntitle: Issue\nstate: %s\nlabels: %s\ncreated_at: 2024-01-01T00:00:00Z\nupdated_at: 2024-01-0%dT00:00:00Z\n---\n", i+1, f.state, f.labels, 5-i)
+		if err := os.WriteFile(filepath.Join(dir, f.name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "006-broken.md"), []byte("---\nnumber: [\n---\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	bug := Filter{Labels: []string{"bug"}}
+	numbers := func(issues []*Issue) []int {
+		var n []int
+		for _, iss := range issues {
+			n = append(n, iss.Number)
+		}
+		return n
+	}
+
+	tests := []struct {
+		name     string
+		match    func(*Issue) bool
+		states   []State
+		want     []int
+		warnings int
+	}{
+		{"all", nil, nil, []int{1, 2, 3, 4, 5}, 1},
+		{"filtered", bug.Match, ActiveStates(), []int{1, 4, 5}, 1},
+		{"filtered by state", bug.Match, []State{StateDone}, []int{3}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewStore(dir)
+			got, err := store.ListMatching(tt.match, tt.states...)
+			if err != nil {
+				t.Fatalf("ListMatching() error = %v", err)
+			}
+			if !slices.Equal(numbers(got), tt.want) {
+				t.Errorf("ListMatching() = %v, want %v", numbers(got), tt.want)
+			}
+			if len(store.Warnings()) != tt.warnings {
+				t.Errorf("Warnings() = %d, want %d", len(store.Warnings()), tt.warnings)
+			}
+		})
+	}
+}
+
 func TestUpdateState(t *testing.T) {
 	tempDir, err := os.MkdirTemp("", "zap-test-update-*")
 	if err != nil {
@@ -270,6 +331,54 @@ Body content.
 	if !updatedIssue.UpdatedAt.After(originalUpdatedAt) {
 		t.Errorf("updated_at should be updated: original=%v, updated=%v", originalUpdatedAt, updatedIssue.UpdatedAt)
 	}
+
+	// Verify the transition was recorded in state_history
+	if len(updatedIssue.StateHistory) != 1 || updatedIssue.StateHistory[0].State != StateWip {
+		t.Errorf("StateHistory = %+v, want one wip entry", updatedIssue.StateHistory)
+	}
+}
+
+func TestPreviewState(t *testing.T) {
+	tempDir := t.TempDir()
+	content := "---\nnumber: 1\ntitle: Test\nstate: wip\ncreated_at: 2024-01-01T00:00:00Z\nupdated_at: 2024-01-01T00:00:00Z\n---\n"
+	filePath := filepath.Join(tempDir, "001-test.md")
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewStore(tempDir)
+	issue, err := store.Get(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := store.PreviewState(issue, StateDone)
+	if err != nil {
+		t.Fatalf("PreviewState failed: %v", err)
+	}
+
+	preview, err := ParseBytes(data, filePath)
+	if err != nil {
+		t.Fatalf("preview does not parse: %v", err)
+	}
+	if preview.State != StateDone || preview.ClosedAt == nil || len(preview.StateHistory) != 1 {
+		t.Errorf("preview = state %s, closed_at %v, history %d; want done with closed_at and 1 history entry",
+			preview.State, preview.ClosedAt, len(preview.StateHistory))
+	}
+
+	// Neither the issue nor the file may change
+	if issue.State != StateWip || issue.ClosedAt != nil || len(issue.StateHistory) != 0 {
+		t.Error("PreviewState modified the issue")
+	}
+	if got, _ := os.ReadFile(filePath); string(got) != content {
+		t.Error("PreviewState modified the file")
+	}
+
+	// Workflow policy applies to previews too
+	store.SetWorkflow(&Workflow{Transitions: map[State][]State{StateWip: {StateOpen}}})
+	if _, err := store.PreviewState(issue, StateDone); err == nil {
+		t.Error("PreviewState should enforce the workflow")
+	}
 }
 
 func TestDetectLegacyStructure(t *testing.T) {
@@ -496,3 +605,30 @@ Body content.
 		t.Error("File should still exist at original location")
 	}
 }
+
+func TestListMatchingLegacyFallback(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "open"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := "---\nnumber: 1\ntitle: Legacy\nstate: open\ncreated_at: 2024-01-01T00:00:00Z\nupdated_at: 2024-01-01T00:00:00Z\n---\n"
+	if err := os.WriteFile(filepath.Join(dir, "open", "001-legacy.md"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// A stray markdown file that is not an issue must not hide the legacy layout
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Issues\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewStore(dir)
+	got, err := store.ListMatching(nil)
+	if err != nil {
+		t.Fatalf("ListMatching() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Number != 1 {
+		t.Fatalf("ListMatching() = %v, want issue #1 from open/", got)
+	}
+	if len(store.Warnings()) != 0 {
+		t.Errorf("Warnings() = %v, want none", store.Warnings())
+	}
+}
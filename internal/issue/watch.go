@@ -0,0 +1,282 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// EventType is the kind of change reported by Store.Watch.
+type EventType string
+
+const (
+	EventCreated EventType = "created"
+	EventUpdated EventType = "updated"
+	EventRemoved EventType = "removed"
+
+	// EventDirRemoved and EventDirRestored report the issues directory
+	// itself going away and coming back, e.g. during a git checkout.
+	EventDirRemoved  EventType = "dir-removed"
+	EventDirRestored EventType = "dir-restored"
+
+	// EventError reports an error from the underlying file watcher.
+	EventError EventType = "error"
+)
+
+// Event is a change to an issue file seen by Store.Watch.
+type Event struct {
+	Type EventType
+	Path string // Issue file, or the issues directory for dir events
+	// Issue is the parsed issue. For EventRemoved it is the last version
+	// seen, if any. It is nil when the file could not be parsed.
+	Issue *Issue
+	Err   error // Parse error for a created/updated file, or watcher error
+}
+
+// DefaultWatchDebounce is how long the directory must be quiet before
+// pending changes are sent, so an editor's write-rename-chmod is one event.
+// See Store.SetWatchDebounce.
+const DefaultWatchDebounce = 100 * time.Millisecond
+
+const (
+	// watchReattachInterval is how often a removed issues directory is
+	// looked for.
+	watchReattachInterval = 500 * time.Millisecond
+)
+
+// SetWatchDebounce sets the quiet period used by Watch. Slow or network
+// filesystems may need a longer one. Zero restores DefaultWatchDebounce.
+func (s *Store) SetWatchDebounce(d time.Duration) {
+	s.watchDebounce = d
+}
+
+// Watch reports changes to issue files in the store's directory until ctx
+// is cancelled, then closes the channel. Only .md files are reported, and
+// changes are debounced. Issues that exist when Watch is called are known,
+// so later changes to them are EventUpdated rather than EventCreated.
+//
+// The state directories of the legacy layout (.issues/{state}/*.md) that
+// exist when Watch is called are watched too. Issues in them take their
+// state from the directory, as in List.
+func (s *Store) Watch(ctx context.Context) (<-chan Event, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watcher: %w", err)
+	}
+	if err := watcher.Add(s.baseDir); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("failed to watch directory: %w", err)
+	}
+
+	w := &storeWatcher{
+		dir:      filepath.Clean(s.baseDir),
+		watcher:  watcher,
+		debounce: s.watchDebounce,
+		known:    make(map[string]*Issue),
+		pending:  make(map[string]bool),
+		events:   make(chan Event, 64),
+	}
+	if w.debounce <= 0 {
+		w.debounce = DefaultWatchDebounce
+	}
+	w.watchStateDirs()
+	w.known = w.scan()
+
+	go w.run(ctx)
+	return w.events, nil
+}
+
+// storeWatcher is the state behind a single Store.Watch call.
+type storeWatcher struct {
+	dir      string
+	watcher  *fsnotify.Watcher
+	debounce time.Duration
+	known    map[string]*Issue // Issue files seen so far; nil value = unparseable
+	pending  map[string]bool   // Files changed since the last flush
+	events   chan Event
+}
+
+func (w *storeWatcher) run(ctx context.Context) {
+	defer close(w.events)
+	defer w.watcher.Close()
+
+	debounce := time.NewTimer(w.debounce)
+	debounce.Stop()
+	defer debounce.Stop()
+
+	var reattach <-chan time.Time
+	var reattachTicker *time.Ticker
+	defer func() {
+		if reattachTicker != nil {
+			reattachTicker.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+
+			if filepath.Clean(event.Name) == w.dir {
+				if (event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename)) && reattachTicker == nil {
+					reattachTicker = time.NewTicker(watchReattachInterval)
+					reattach = reattachTicker.C
+					if !w.send(ctx, Event{Type: EventDirRemoved, Path: w.dir}) {
+						return
+					}
+				}
+				continue
+			}
+
+			if !strings.HasSuffix(event.Name, ".md") {
+				continue
+			}
+			w.pending[event.Name] = true
+			debounce.Reset(w.debounce)
+
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			if !w.send(ctx, Event{Type: EventError, Err: err}) {
+				return
+			}
+
+		case <-reattach:
+			if info, err := os.Stat(w.dir); err != nil || !info.IsDir() || w.watcher.Add(w.dir) != nil {
+				continue
+			}
+			reattachTicker.Stop()
+			reattachTicker, reattach = nil, nil
+			w.watchStateDirs()
+			if !w.send(ctx, Event{Type: EventDirRestored, Path: w.dir}) {
+				return
+			}
+
+			// Files may have changed while the directory was gone
+			for path := range w.known {
+				w.pending[path] = true
+			}
+			for path := range w.scan() {
+				w.pending[path] = true
+			}
+			if !w.flush(ctx) {
+				return
+			}
+
+		case <-debounce.C:
+			if !w.flush(ctx) {
+				return
+			}
+		}
+	}
+}
+
+// watchStateDirs adds the existing legacy state directories to the watcher.
+func (w *storeWatcher) watchStateDirs() {
+	for _, state := range AllStates() {
+		dir := filepath.Join(w.dir, StateDir(state))
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			_ = w.watcher.Add(dir)
+		}
+	}
+}
+
+// scan parses every issue file currently in the directory and in the
+// legacy state directories.
+func (w *storeWatcher) scan() map[string]*Issue {
+	found := make(map[string]*Issue)
+	dirs := []string{w.dir}
+	for _, state := range AllStates() {
+		dirs = append(dirs, filepath.Join(w.dir, StateDir(state)))
+	}
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			iss, _ := w.parse(path)
+			found[path] = iss
+		}
+	}
+	return found
+}
+
+// parse parses an issue file. A file in a legacy state directory takes its
+// state from the directory.
+func (w *storeWatcher) parse(path string) (*Issue, error) {
+	iss, err := Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	if dir := filepath.Dir(path); dir != w.dir {
+		if def, ok := LookupState(State(filepath.Base(dir))); ok {
+			iss.State = def.Name
+		}
+	}
+	return iss, nil
+}
+
+// flush sends one event per pending file, in filename order.
+// It returns false if ctx was cancelled.
+func (w *storeWatcher) flush(ctx context.Context) bool {
+	paths := make([]string, 0, len(w.pending))
+	for path := range w.pending {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	w.pending = make(map[string]bool)
+
+	for _, path := range paths {
+		prev, known := w.known[path]
+
+		if _, err := os.Stat(path); err != nil {
+			if !known {
+				continue
+			}
+			delete(w.known, path)
+			if !w.send(ctx, Event{Type: EventRemoved, Path: path, Issue: prev}) {
+				return false
+			}
+			continue
+		}
+
+		iss, err := w.parse(path)
+		w.known[path] = iss
+
+		event := Event{Type: EventUpdated, Path: path, Issue: iss, Err: err}
+		if !known {
+			event.Type = EventCreated
+		}
+		if !w.send(ctx, event) {
+			return false
+		}
+	}
+	return true
+}
+
+// send delivers an event unless ctx is cancelled first.
+func (w *storeWatcher) send(ctx context.Context, event Event) bool {
+	select {
+	case w.events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,161 @@
+package issue
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStoreWatch(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	write := func(name, title string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		content := "---\nnumber: 1\ntitle: " + title + "\nstate: open\n---\n"
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	existing := write("001-existing.md", "Existing")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := NewStore(dir).Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Several writes to a file settle into a single event
+	created := write("002-new.md", "Draft")
+	write("002-new.md", "New")
+	event := nextEvent(t, events)
+	if event.Type != EventCreated || event.Path != created || event.Issue == nil || event.Issue.Title != "New" {
+		t.Fatalf("got %+v, want created %s titled New", event, created)
+	}
+
+	write("001-existing.md", "Changed")
+	if event := nextEvent(t, events); event.Type != EventUpdated || event.Path != existing {
+		t.Fatalf("got %+v, want updated %s", event, existing)
+	}
+
+	// Non-markdown files are ignored; an unparseable issue carries its error
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "003-broken.md"), []byte("no frontmatter"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if event := nextEvent(t, events); event.Issue != nil || event.Err == nil {
+		t.Fatalf("got %+v, want a parse error for 003-broken.md", event)
+	}
+
+	if err := os.Remove(created); err != nil {
+		t.Fatal(err)
+	}
+	event = nextEvent(t, events)
+	if event.Type != EventRemoved || event.Path != created || event.Issue == nil || event.Issue.Title != "New" {
+		t.Fatalf("got %+v, want removed %s with its last version", event, created)
+	}
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestStoreWatchDirRestored(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := NewStore(dir).Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	if event := nextEvent(t, events); event.Type != EventDirRemoved {
+		t.Fatalf("got %+v, want %s", event, EventDirRemoved)
+	}
+
+	// Files present when the directory comes back are reported
+	tmp := dir + ".tmp"
+	if err := os.Mkdir(tmp, 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := "---\nnumber: 1\ntitle: Restored\nstate: open\n---\n"
+	if err := os.WriteFile(filepath.Join(tmp, "001-restored.md"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	if event := nextEvent(t, events); event.Type != EventDirRestored {
+		t.Fatalf("got %+v, want %s", event, EventDirRestored)
+	}
+	if event := nextEvent(t, events); event.Type != EventCreated || event.Issue == nil || event.Issue.Title != "Restored" {
+		t.Fatalf("got %+v, want created issue titled Restored", event)
+	}
+}
+
+func TestStoreWatchLegacyLayout(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".issues")
+	wipDir := filepath.Join(dir, "wip")
+	if err := os.MkdirAll(wipDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(wipDir, "001-legacy.md")
+	write := func(title string) {
+		t.Helper()
+		content := "---\nnumber: 1\ntitle: " + title + "\nstate: open\n---\n"
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("Legacy")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := NewStore(dir).Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The state comes from the directory, not the frontmatter
+	write("Changed")
+	event := nextEvent(t, events)
+	if event.Type != EventUpdated || event.Path != path || event.Issue == nil || event.Issue.State != StateWip {
+		t.Fatalf("got %+v, want updated %s in state wip", event, path)
+	}
+}
+
+// nextEvent waits for the next watch event, skipping watcher errors.
+func nextEvent(t *testing.T, events <-chan Event) Event {
+	t.Helper()
+	timeout := time.After(3 * time.Second)
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatal("events channel closed")
+			}
+			if event.Type == EventError {
+				t.Logf("watch error: %v", event.Err)
+				continue
+			}
+			return event
+		case <-timeout:
+			t.Fatal("timed out waiting for watch event")
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package issue
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Workflow restricts which state transitions are allowed.
+// A nil Workflow, or a state without an entry in Transitions, allows every transition.
+type Workflow struct {
+	// Transitions maps a state to the states it may move to
+	Transitions map[State][]State `yaml:"transitions"`
+}
+
+// TransitionError is returned when a workflow rejects a state change.
+type TransitionError struct {
+	From    State
+	To      State
+	Allowed []State
+}
+
+func (e *TransitionError) Error() string {
+	allowed := make([]string, len(e.Allowed))
+	for i, s := range e.Allowed {
+		allowed[i] = string(s)
+	}
+	if len(allowed) == 0 {
+		return fmt.Sprintf("transition %s → %s is not allowed by workflow (%s is final)", e.From, e.To, e.From)
+	}
+	return fmt.Sprintf("transition %s → %s is not allowed by workflow (allowed: %s)",
+		e.From, e.To, strings.Join(allowed, ", "))
+}
+
+// Check returns a *TransitionError if moving from one state to another is not allowed.
+func (w *Workflow) Check(from, to State) error {
+	if w == nil || from == to {
+		return nil
+	}
+
+	allowed, ok := w.Transitions[from]
+	if !ok {
+		return nil
+	}
+
+	for _, s := range allowed {
+		if s == to {
+			return nil
+		}
+	}
+
+	return &TransitionError{From: from, To: to, Allowed: allowed}
+}
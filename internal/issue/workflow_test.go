@@ -0,0 +1,42 @@
+package issue
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWorkflowCheck(t *testing.T) {
+	w := &Workflow{Transitions: map[State][]State{
+		StateOpen: {StateWip, StateClosed},
+		StateWip:  {StateDone, StateOpen},
+		StateDone: {},
+	}}
+
+	tests := []struct {
+		name    string
+		wf      *Workflow
+		from    State
+		to      State
+		wantErr bool
+	}{
+		{"allowed transition", w, StateOpen, StateWip, false},
+		{"skipping wip", w, StateOpen, StateDone, true},
+		{"final state", w, StateDone, StateOpen, true},
+		{"state without rule", w, StateClosed, StateOpen, false},
+		{"same state", w, StateOpen, StateOpen, false},
+		{"nil workflow", nil, StateOpen, StateDone, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.wf.Check(tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Check(%s, %s) error = %v, wantErr %v", tt.from, tt.to, err, tt.wantErr)
+			}
+			var transitionErr *TransitionError
+			if err != nil && !errors.As(err, &transitionErr) {
+				t.Errorf("error should be a *TransitionError, got %T", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Package notify sends desktop notifications on macOS, Linux and Windows.
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnsupported is returned when no notification backend is available.
+var ErrUnsupported = errors.New("no desktop notification backend available")
+
+// Bell is where the terminal bell is written when no backend is available.
+var Bell io.Writer = os.Stdout
+
+// Send shows a desktop notification using the platform's backend:
+// osascript on macOS, notify-send on Linux/BSD and a PowerShell toast on Windows.
+// If no backend is available or it fails, the terminal bell is rung instead
+// and an error is returned.
+func Send(title, message string) error {
+	name, args := command(runtime.GOOS, title, message)
+	if name == "" {
+		ringBell()
+		return ErrUnsupported
+	}
+
+	if _, err := exec.LookPath(name); err != nil {
+		ringBell()
+		return fmt.Errorf("%w: %s not found", ErrUnsupported, name)
+	}
+
+	if err := exec.Command(name, args...).Run(); err != nil {
+		ringBell()
+		return fmt.Errorf("%s failed: %w", name, err)
+	}
+
+	return nil
+}
+
+// command returns the program and arguments that show a notification on goos.
+// An empty name means the platform has no supported backend.
+func command(goos, title, message string) (string, []string) {
+	switch goos {
+	case "darwin":
+		script := fmt.Sprintf(`display notification %s with title %s`,
+			appleScriptString(message), appleScriptString(title))
+		return "osascript", []string{"-e", script}
+
+	case "linux", "freebsd", "openbsd", "netbsd", "dragonfly":
+		return "notify-send", []string{"--app-name=zap", title, message}
+
+	case "windows":
+		return "powershell", []string{"-NoProfile", "-NonInteractive", "-Command", windowsToastScript(title, message)}
+	}
+
+	return "", nil
+}
+
+// appleScriptString quotes s as an AppleScript string literal.
+func appleScriptString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+// powerShellString quotes s as a single-quoted PowerShell string literal.
+func powerShellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// windowsToastScript builds a PowerShell script that shows a toast notification.
+func windowsToastScript(title, message string) string {
+	return strings.Join([]string{
+		`[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] > $null`,
+		`$t = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02)`,
+		`$x = $t.GetElementsByTagName('text')`,
+		`$x.Item(0).AppendChild($t.CreateTextNode(` + powerShellString(title) + `)) > $null`,
+		`$x.Item(1).AppendChild($t.CreateTextNode(` + powerShellString(message) + `)) > $null`,
+		`[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier('zap').Show([Windows.UI.Notifications.ToastNotification]::new($t))`,
+	}, "; ")
+}
+
+func ringBell() {
+	fmt.Fprint(Bell, "\a")
+}
@@ -0,0 +1,40 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCommand(t *testing.T) {
+	tests := []struct {
+		goos     string
+		wantName string
+		wantArg  string
+	}{
+		{"darwin", "osascript", `display notification "#1: say \"hi\"" with title "Done"`},
+		{"linux", "notify-send", "#1: say \"hi\""},
+		{"windows", "powershell", `CreateTextNode('#1: say "hi"')`},
+		{"plan9", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goos, func(t *testing.T) {
+			name, args := command(tt.goos, "Done", `#1: say "hi"`)
+			if name != tt.wantName {
+				t.Fatalf("command(%s) name = %q, want %q", tt.goos, name, tt.wantName)
+			}
+			if tt.wantArg == "" {
+				return
+			}
+			if !strings.Contains(strings.Join(args, " "), tt.wantArg) {
+				t.Errorf("command(%s) args = %q, want containing %q", tt.goos, args, tt.wantArg)
+			}
+		})
+	}
+}
+
+func TestPowerShellStringEscapesQuotes(t *testing.T) {
+	if got, want := powerShellString("it's"), "'it''s'"; got != want {
+		t.Errorf("powerShellString() = %s, want %s", got, want)
+	}
+}
@@ -3,6 +3,9 @@ package project
 import (
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/itda-work/zap/internal/issue"
 )
@@ -63,24 +66,63 @@ func (ms *MultiStore) IsMultiProject() bool {
 	return len(ms.projects) > 1
 }
 
-// ListAll returns all issues from all projects, optionally filtered by state
-func (ms *MultiStore) ListAll(states ...issue.State) ([]*ProjectIssue, error) {
-	var allIssues []*ProjectIssue
+// maxConcurrentProjects bounds how many projects are parsed at once
+const maxConcurrentProjects = 8
 
-	for _, alias := range ms.order {
-		proj := ms.projects[alias]
-		issues, err := proj.Store.List(states...)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list issues from %s: %w", alias, err)
+// collect runs fn for every project concurrently (bounded by
+// maxConcurrentProjects) and merges the results, sorted by project alias
+// then issue number. If any project fails, the error from the first project
+// in order is returned.
+func (ms *MultiStore) collect(what string, fn func(*Project) ([]*issue.Issue, error)) ([]*ProjectIssue, error) {
+	results := make([][]*issue.Issue, len(ms.order))
+	errs := make([]error, len(ms.order))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentProjects)
+
+	for i, alias := range ms.order {
+		wg.Add(1)
+		go func(i int, proj *Project) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i], errs[i] = fn(proj)
+		}(i, ms.projects[alias])
+	}
+	wg.Wait()
+
+	var merged []*ProjectIssue
+	for i, alias := range ms.order {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("failed to %s from %s: %w", what, alias, errs[i])
+		}
+		for _, iss := range results[i] {
+			merged = append(merged, NewProjectIssue(iss, alias))
 		}
+	}
 
-		for _, iss := range issues {
-			allIssues = append(allIssues, NewProjectIssue(iss, alias))
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].Project != merged[j].Project {
+			return merged[i].Project < merged[j].Project
 		}
+		return merged[i].Number < merged[j].Number
+	})
+
+	return merged, nil
+}
+
+// ListAll returns all issues from all projects, optionally filtered by state
+func (ms *MultiStore) ListAll(states ...issue.State) ([]*ProjectIssue, error) {
+	allIssues, err := ms.collect("list issues", func(p *Project) ([]*issue.Issue, error) {
+		return p.Store.List(states...)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Sort by created_at descending (newest first)
-	sort.Slice(allIssues, func(i, j int) bool {
+	sort.SliceStable(allIssues, func(i, j int) bool {
 		return allIssues[i].CreatedAt.After(allIssues[j].CreatedAt)
 	})
 
@@ -107,6 +149,44 @@ func (ms *MultiStore) GetByRef(ref *ProjectRef) (*ProjectIssue, error) {
 	return ms.Get(ref.Project, ref.Number)
 }
 
+// Resolve finds an issue from a command-line argument.
+// The argument may be a project reference ("api/12", "api/#12") or a bare
+// number. A bare number is looked up in the given project alias if set,
+// otherwise across all projects; it is an error if it matches more than one.
+func (ms *MultiStore) Resolve(arg, alias string) (*ProjectIssue, error) {
+	if IsProjectRef(arg) {
+		ref, err := ParseRef(arg)
+		if err != nil {
+			return nil, err
+		}
+		return ms.GetByRef(ref)
+	}
+
+	number, err := strconv.Atoi(strings.TrimPrefix(arg, "#"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid issue reference: %s (expected: number or project/number)", arg)
+	}
+
+	if alias != "" {
+		return ms.Get(alias, number)
+	}
+
+	matches := ms.FindByNumber(number)
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("issue #%d not found in any project", number)
+	case 1:
+		return matches[0], nil
+	}
+
+	refs := make([]string, len(matches))
+	for i, m := range matches {
+		refs[i] = m.Ref()
+	}
+	return nil, fmt.Errorf("issue #%d exists in multiple projects (%s); use project/number to choose one",
+		number, strings.Join(refs, ", "))
+}
+
 // FindByNumber searches for an issue by number across all projects
 // Returns all matching issues (there may be collisions)
 func (ms *MultiStore) FindByNumber(number int) []*ProjectIssue {
@@ -137,16 +217,19 @@ func (ms *MultiStore) MoveByRef(ref *ProjectRef, newState issue.State) error {
 	return ms.Move(ref.Project, ref.Number, newState)
 }
 
-// Stats returns aggregated statistics from all projects
+// MultiStats holds statistics of every project and their totals
 type MultiStats struct {
 	Total     int
+	Projects  []string // Project aliases in order
 	ByProject map[string]*issue.Stats
+	Combined  *issue.Stats // Sum over all projects
 }
 
 // Stats returns statistics for all projects
 func (ms *MultiStore) Stats() (*MultiStats, error) {
 	stats := &MultiStats{
 		ByProject: make(map[string]*issue.Stats),
+		Combined:  issue.NewStats(),
 	}
 
 	for _, alias := range ms.order {
@@ -155,7 +238,9 @@ func (ms *MultiStore) Stats() (*MultiStats, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to get stats from %s: %w", alias, err)
 		}
+		stats.Projects = append(stats.Projects, alias)
 		stats.ByProject[alias] = projStats
+		stats.Combined.Merge(projStats)
 		stats.Total += projStats.Total
 	}
 
@@ -187,38 +272,16 @@ func (ms *MultiStore) Warnings() []ProjectWarning {
 
 // FilterByLabel returns issues with a specific label from all projects
 func (ms *MultiStore) FilterByLabel(label string, states ...issue.State) ([]*ProjectIssue, error) {
-	var results []*ProjectIssue
-
-	for _, alias := range ms.order {
-		proj := ms.projects[alias]
-		issues, err := proj.Store.FilterByLabel(label, states...)
-		if err != nil {
-			return nil, fmt.Errorf("failed to filter by label from %s: %w", alias, err)
-		}
-		for _, iss := range issues {
-			results = append(results, NewProjectIssue(iss, alias))
-		}
-	}
-
-	return results, nil
+	return ms.collect("filter by label", func(p *Project) ([]*issue.Issue, error) {
+		return p.Store.FilterByLabel(label, states...)
+	})
 }
 
 // FilterByAssignee returns issues assigned to a specific person from all projects
 func (ms *MultiStore) FilterByAssignee(assignee string, states ...issue.State) ([]*ProjectIssue, error) {
-	var results []*ProjectIssue
-
-	for _, alias := range ms.order {
-		proj := ms.projects[alias]
-		issues, err := proj.Store.FilterByAssignee(assignee, states...)
-		if err != nil {
-			return nil, fmt.Errorf("failed to filter by assignee from %s: %w", alias, err)
-		}
-		for _, iss := range issues {
-			results = append(results, NewProjectIssue(iss, alias))
-		}
-	}
-
-	return results, nil
+	return ms.collect("filter by assignee", func(p *Project) ([]*issue.Issue, error) {
+		return p.Store.FilterByAssignee(assignee, states...)
+	})
 }
 
 // Search searches for issues matching a keyword across all projects
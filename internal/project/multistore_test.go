@@ -0,0 +1,150 @@
+package project
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/itda-work/zap/internal/issue"
+)
+
+// newTestMultiStore creates projects with the given issue numbers under a temp dir
+func newTestMultiStore(t *testing.T, projects map[string][]int) *MultiStore {
+	t.Helper()
+	root := t.TempDir()
+
+	var specs []ProjectSpec
+	for alias, numbers := range projects {
+		issuesDir := filepath.Join(root, alias, ".issues")
+		if err := os.MkdirAll(issuesDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for _, n := range numbers {
+			content := fmt.Sprintf("---\nnumber: %d\ntitle: %s issue %d\nstate: open\n---\n", n, alias, n)
+			path := filepath.Join(issuesDir, fmt.Sprintf("%03d-test.md", n))
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		specs = append(specs, ProjectSpec{Alias: alias, Path: filepath.Join(root, alias)})
+	}
+
+	ms, err := NewMultiStore(specs, ".issues")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ms
+}
+
+func TestMultiStoreResolve(t *testing.T) {
+	ms := newTestMultiStore(t, map[string][]int{
+		"api": {1, 12},
+		"web": {1, 5},
+	})
+
+	tests := []struct {
+		name    string
+		arg     string
+		alias   string
+		wantRef string
+		wantErr string
+	}{
+		{"ref with hash", "api/#12", "", "api/#12", ""},
+		{"ref without hash", "web/5", "", "web/#5", ""},
+		{"unique bare number", "12", "", "api/#12", ""},
+		{"bare number with alias", "1", "web", "web/#1", ""},
+		{"ambiguous bare number", "1", "", "", "multiple projects"},
+		{"missing number", "99", "", "", "not found"},
+		{"unknown project", "db/1", "", "", "project not found"},
+		{"invalid argument", "abc", "", "", "invalid issue reference"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pIss, err := ms.Resolve(tt.arg, tt.alias)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Resolve(%q) error = %v, want containing %q", tt.arg, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve(%q) failed: %v", tt.arg, err)
+			}
+			if pIss.Ref() != tt.wantRef {
+				t.Errorf("Resolve(%q) = %s, want %s", tt.arg, pIss.Ref(), tt.wantRef)
+			}
+		})
+	}
+}
+
+func TestMultiStoreCollectOrder(t *testing.T) {
+	ms := newTestMultiStore(t, map[string][]int{
+		"web":  {3, 1},
+		"api":  {2, 10, 1},
+		"docs": {7},
+	})
+
+	all, err := ms.collect("list issues", func(p *Project) ([]*issue.Issue, error) {
+		return p.Store.List()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var refs []string
+	for _, pIss := range all {
+		refs = append(refs, pIss.Ref())
+	}
+	want := "api/#1 api/#2 api/#10 docs/#7 web/#1 web/#3"
+	if got := strings.Join(refs, " "); got != want {
+		t.Errorf("collect order = %s, want %s", got, want)
+	}
+}
+
+func TestMultiStoreWarningsAfterListAll(t *testing.T) {
+	ms := newTestMultiStore(t, map[string][]int{
+		"api": {1},
+		"web": {1},
+	})
+
+	for _, proj := range ms.Projects() {
+		broken := filepath.Join(proj.IssuesDir(".issues"), "002-broken.md")
+		if err := os.WriteFile(broken, []byte("---\nnumber: [\n---\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := ms.ListAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	warnings := ms.Warnings()
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %d", len(warnings))
+	}
+}
+
+func TestMultiStoreStats(t *testing.T) {
+	ms := newTestMultiStore(t, map[string][]int{
+		"web": {1, 2},
+		"api": {1, 2, 3},
+	})
+
+	stats, err := ms.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stats.Projects) != 2 {
+		t.Errorf("Projects = %v, want both projects", stats.Projects)
+	}
+	if stats.ByProject["api"].Total != 3 || stats.ByProject["web"].Total != 2 {
+		t.Errorf("per-project totals = api %d, web %d, want 3 and 2", stats.ByProject["api"].Total, stats.ByProject["web"].Total)
+	}
+	if stats.Total != 5 || stats.Combined.Total != 5 || stats.Combined.ByState[issue.StateOpen] != 5 {
+		t.Errorf("combined = total %d, %+v, want 5 open", stats.Total, stats.Combined)
+	}
+}
@@ -85,11 +85,11 @@ func ParseProjectSpec(spec string) ProjectSpec {
 	return ProjectSpec{Path: spec}
 }
 
-// refPattern matches "project/#number" format
-var refPattern = regexp.MustCompile(`^([a-zA-Z0-9_-]+)/#(\d+)$`)
+// refPattern matches "project/#number" or "project/number" format
+var refPattern = regexp.MustCompile(`^([a-zA-Z0-9_-]+)/#?(\d+)$`)
 
 // ParseRef parses a project issue reference string
-// Format: "project/#number" (e.g., "zap/#1", "alfred/#5")
+// Format: "project/#number" or "project/number" (e.g., "zap/#1", "alfred/5")
 func ParseRef(ref string) (*ProjectRef, error) {
 	matches := refPattern.FindStringSubmatch(ref)
 	if matches == nil {
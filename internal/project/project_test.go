@@ -99,9 +99,11 @@ func TestParseRef(t *testing.T) {
 			wantErr:     false,
 		},
 		{
-			name:    "invalid - no hash",
-			input:   "zap/1",
-			wantErr: true,
+			name:        "ref without hash",
+			input:       "zap/1",
+			wantProject: "zap",
+			wantNumber:  1,
+			wantErr:     false,
 		},
 		{
 			name:    "invalid - missing number",
@@ -150,7 +152,7 @@ func TestIsProjectRef(t *testing.T) {
 		{"my_project/#5", true},
 		{"1", false},
 		{"#1", false},
-		{"zap/1", false},
+		{"zap/1", true},
 		{"zap#1", false},
 		{"", false},
 	}
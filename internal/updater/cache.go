@@ -0,0 +1,128 @@
+package updater
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// CheckCacheTTL is how long a cached latest-release result is reused.
+const CheckCacheTTL = 24 * time.Hour
+
+// checkCache is the on-disk record of the last latest-release check.
+type checkCache struct {
+	CheckedAt   time.Time    `json:"checked_at"`
+	AttemptedAt time.Time    `json:"attempted_at"` // last fetch, successful or not
+	Release     *ReleaseInfo `json:"release,omitempty"`
+	RetryAfter  time.Time    `json:"retry_after,omitempty"` // rate limit reset
+}
+
+// DefaultCachePath returns the file used to cache update checks.
+func DefaultCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "zap", "update-check.json"), nil
+}
+
+// CheckDue reports whether a background check should ask GitHub again:
+// the last attempt is older than CheckCacheTTL and no rate limit is pending.
+func CheckDue(cachePath string, now time.Time) bool {
+	cache := loadCheckCache(cachePath)
+	return now.Sub(cache.AttemptedAt) >= CheckCacheTTL && !now.Before(cache.RetryAfter)
+}
+
+// CachedRelease returns the release stored by the last successful check
+// without asking GitHub, or nil when there is none.
+func CachedRelease(cachePath string) *ReleaseInfo {
+	return loadCheckCache(cachePath).Release
+}
+
+// CheckForUpdateCached is like CheckForUpdate, but reuses a result cached at
+// cachePath for CheckCacheTTL. force skips the cached result.
+// After a rate limit error, GitHub is not asked again until the limit
+// resets; a previously cached result is returned meanwhile if there is one.
+func (u *Updater) CheckForUpdateCached(cachePath string, force bool) (*UpdateInfo, error) {
+	release, checkedAt, err := cachedLatestRelease(cachePath, CheckCacheTTL, force, time.Now(), u.github.GetLatestRelease)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateInfo{
+		CurrentVersion:  u.currentVersion,
+		LatestVersion:   release.TagName,
+		UpdateAvailable: CompareVersions(u.currentVersion, release.TagName) < 0,
+		ReleaseInfo:     release,
+		CheckedAt:       checkedAt,
+	}, nil
+}
+
+// cachedLatestRelease returns the latest release from the cache or fetch,
+// along with when it was fetched.
+func cachedLatestRelease(path string, ttl time.Duration, force bool, now time.Time, fetch func() (*ReleaseInfo, error)) (*ReleaseInfo, time.Time, error) {
+	cache := loadCheckCache(path)
+
+	if cache.Release != nil && !force && now.Sub(cache.CheckedAt) < ttl {
+		return cache.Release, cache.CheckedAt, nil
+	}
+
+	if now.Before(cache.RetryAfter) {
+		if cache.Release != nil {
+			return cache.Release, cache.CheckedAt, nil
+		}
+		return nil, time.Time{}, &RateLimitError{ResetTime: strconv.FormatInt(cache.RetryAfter.Unix(), 10)}
+	}
+
+	release, err := fetch()
+	if err != nil {
+		cache.AttemptedAt = now
+		var rateLimitErr *RateLimitError
+		limited := errors.As(err, &rateLimitErr)
+		if limited {
+			if reset, ok := rateLimitErr.Reset(); ok {
+				cache.RetryAfter = reset
+			}
+		}
+		_ = saveCheckCache(path, cache)
+
+		if limited && cache.Release != nil {
+			return cache.Release, cache.CheckedAt, nil
+		}
+		return nil, time.Time{}, err
+	}
+
+	cache = checkCache{CheckedAt: now, AttemptedAt: now, Release: release}
+	if err := saveCheckCache(path, cache); err != nil {
+		return nil, time.Time{}, fmt.Errorf("save update cache: %w", err)
+	}
+	return release, now, nil
+}
+
+// loadCheckCache reads the cache file. A missing or broken file is empty.
+func loadCheckCache(path string) checkCache {
+	var cache checkCache
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return checkCache{}
+	}
+	return cache
+}
+
+func saveCheckCache(path string, cache checkCache) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,114 @@
+package updater
+
+import (
+	"errors"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestCachedLatestRelease(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "update-check.json")
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	ttl := 24 * time.Hour
+
+	calls := 0
+	release := &ReleaseInfo{TagName: "v1.2.0"}
+	fetch := func() (*ReleaseInfo, error) {
+		calls++
+		return release, nil
+	}
+
+	// First check fetches and caches
+	got, _, err := cachedLatestRelease(path, ttl, false, now, fetch)
+	if err != nil || got.TagName != "v1.2.0" || calls != 1 {
+		t.Fatalf("first check: got %v, err %v, calls %d", got, err, calls)
+	}
+
+	// Within the TTL the cache is used
+	if _, checkedAt, _ := cachedLatestRelease(path, ttl, false, now.Add(time.Hour), fetch); calls != 1 || !checkedAt.Equal(now) {
+		t.Errorf("cached check: calls = %d, checkedAt = %v", calls, checkedAt)
+	}
+
+	// force bypasses the cache
+	if _, _, _ = cachedLatestRelease(path, ttl, true, now.Add(time.Hour), fetch); calls != 2 {
+		t.Errorf("forced check: calls = %d, want 2", calls)
+	}
+
+	// After the TTL it fetches again
+	if _, _, _ = cachedLatestRelease(path, ttl, false, now.Add(30*time.Hour), fetch); calls != 3 {
+		t.Errorf("expired check: calls = %d, want 3", calls)
+	}
+}
+
+func TestCachedLatestReleaseRateLimit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "update-check.json")
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	reset := now.Add(30 * time.Minute)
+
+	calls := 0
+	limited := func() (*ReleaseInfo, error) {
+		calls++
+		return nil, &RateLimitError{ResetTime: strconv.FormatInt(reset.Unix(), 10)}
+	}
+
+	_, _, err := cachedLatestRelease(path, time.Hour, false, now, limited)
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("expected RateLimitError, got %v", err)
+	}
+
+	// Until the reset, GitHub is not asked again, even with force
+	if _, _, err := cachedLatestRelease(path, time.Hour, true, now.Add(10*time.Minute), limited); !errors.As(err, &rateLimitErr) || calls != 1 {
+		t.Errorf("during backoff: err = %v, calls = %d", err, calls)
+	}
+
+	// After the reset it tries again
+	ok := func() (*ReleaseInfo, error) {
+		calls++
+		return &ReleaseInfo{TagName: "v1.3.0"}, nil
+	}
+	if got, _, err := cachedLatestRelease(path, time.Hour, false, reset.Add(time.Second), ok); err != nil || got.TagName != "v1.3.0" {
+		t.Errorf("after reset: got %v, err %v", got, err)
+	}
+}
+
+func TestCheckDue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "update-check.json")
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	if !CheckDue(path, now) {
+		t.Error("CheckDue should be true without a cache file")
+	}
+
+	// A failed attempt still counts toward the daily cadence
+	failing := func() (*ReleaseInfo, error) { return nil, errors.New("offline") }
+	_, _, _ = cachedLatestRelease(path, CheckCacheTTL, false, now, failing)
+	if CheckDue(path, now.Add(time.Hour)) {
+		t.Error("CheckDue should be false within a day of the last attempt")
+	}
+	if !CheckDue(path, now.Add(25*time.Hour)) {
+		t.Error("CheckDue should be true a day after the last attempt")
+	}
+}
+
+func TestCachedRelease(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "update-check.json")
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	if got := CachedRelease(path); got != nil {
+		t.Errorf("CachedRelease without a cache file = %v, want nil", got)
+	}
+
+	fetch := func() (*ReleaseInfo, error) { return &ReleaseInfo{TagName: "v1.2.0"}, nil }
+	_, _, _ = cachedLatestRelease(path, CheckCacheTTL, false, now, fetch)
+
+	// A later failed attempt keeps the stored release
+	failing := func() (*ReleaseInfo, error) { return nil, errors.New("offline") }
+	_, _, _ = cachedLatestRelease(path, CheckCacheTTL, true, now.Add(time.Hour), failing)
+
+	if got := CachedRelease(path); got == nil || got.TagName != "v1.2.0" {
+		t.Errorf("CachedRelease = %v, want v1.2.0", got)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -300,5 +301,17 @@ type RateLimitError struct {
 }
 
 func (e *RateLimitError) Error() string {
+	if reset, ok := e.Reset(); ok {
+		return fmt.Sprintf("rate limit exceeded, resets at %s", reset.Local().Format("15:04"))
+	}
 	return fmt.Sprintf("rate limit exceeded, resets at %s", e.ResetTime)
 }
+
+// Reset parses ResetTime (Unix seconds, from X-RateLimit-Reset).
+func (e *RateLimitError) Reset() (time.Time, bool) {
+	sec, err := strconv.ParseInt(e.ResetTime, 10, 64)
+	if err != nil || sec <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(sec, 0), true
+}
@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 )
 
 // UpdateInfo contains the result of an update check.
@@ -17,6 +19,7 @@ type UpdateInfo struct {
 	LatestVersion   string
 	UpdateAvailable bool
 	ReleaseInfo     *ReleaseInfo
+	CheckedAt       time.Time // when the release was fetched (set by CheckForUpdateCached)
 }
 
 // Updater handles the self-update process.
@@ -189,6 +192,7 @@ func (u *Updater) VerifyChecksum(filePath, assetName string, checksums map[strin
 }
 
 // AtomicReplace safely replaces the current binary with a new one.
+// The replaced binary is kept at PreviousPath for Rollback.
 func (u *Updater) AtomicReplace(newBinaryPath string) error {
 	// Create backup path
 	backupPath := u.execPath + ".old"
@@ -221,7 +225,69 @@ func (u *Updater) AtomicReplace(newBinaryPath string) error {
 		}
 	}
 
-	// Remove backup on success
+	// Keep the replaced binary for rollback; only the last one is kept
+	_ = os.Remove(u.PreviousPath())
+	if err := os.Rename(backupPath, u.PreviousPath()); err != nil {
+		_ = os.Remove(backupPath)
+	}
+
+	return nil
+}
+
+// PreviousPath returns where the binary replaced by the last update is kept.
+func (u *Updater) PreviousPath() string {
+	return u.execPath + ".prev"
+}
+
+// CheckPrevious verifies that the previous binary exists and runs, and
+// returns what it prints for --version.
+func (u *Updater) CheckPrevious() (string, error) {
+	prevPath := u.PreviousPath()
+	info, err := os.Stat(prevPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", &NoPreviousError{Path: prevPath}
+		}
+		return "", fmt.Errorf("stat previous binary: %w", err)
+	}
+	if !info.Mode().IsRegular() || info.Size() == 0 {
+		return "", fmt.Errorf("previous binary %s is not a regular file", prevPath)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0111 == 0 {
+		return "", fmt.Errorf("previous binary %s is not executable", prevPath)
+	}
+
+	out, err := exec.Command(prevPath, "--version").Output()
+	if err != nil {
+		return "", fmt.Errorf("previous binary %s does not run: %w", prevPath, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Rollback restores the binary replaced by the last update. Only one
+// version is kept, so after a rollback there is nothing left to roll back
+// to until the next update.
+func (u *Updater) Rollback() error {
+	if _, err := u.CheckPrevious(); err != nil {
+		return err
+	}
+
+	backupPath := u.execPath + ".old"
+	_ = os.Remove(backupPath)
+
+	if err := os.Rename(u.execPath, backupPath); err != nil {
+		return &PermissionError{
+			Path:    u.execPath,
+			Message: fmt.Sprintf("cannot rename current binary: %v", err),
+		}
+	}
+	if err := os.Rename(u.PreviousPath(), u.execPath); err != nil {
+		_ = os.Rename(backupPath, u.execPath)
+		return fmt.Errorf("restore previous binary: %w", err)
+	}
+
+	// The running binary cannot be removed on Windows; it is cleaned up by
+	// the next update
 	_ = os.Remove(backupPath)
 
 	return nil
@@ -324,6 +390,15 @@ func (e *ChecksumError) Error() string {
 	return "checksum verification failed"
 }
 
+// NoPreviousError indicates there is no previous binary to roll back to.
+type NoPreviousError struct {
+	Path string
+}
+
+func (e *NoPreviousError) Error() string {
+	return fmt.Sprintf("no previous version to roll back to (%s not found)", e.Path)
+}
+
 // PermissionError indicates a permission problem.
 type PermissionError struct {
 	Path    string
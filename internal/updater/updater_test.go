@@ -0,0 +1,72 @@
+package updater
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// writeFakeBinary writes a script that prints a zap version, standing in
+// for a release binary.
+func writeFakeBinary(t *testing.T, path, version string) {
+	t.Helper()
+	script := "#!/bin/sh\necho zap version " + version + "\n"
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake binaries are shell scripts")
+	}
+
+	dir := t.TempDir()
+	execPath := filepath.Join(dir, "zap")
+	writeFakeBinary(t, execPath, "v1.0.0")
+	u := &Updater{currentVersion: "v1.0.0", execPath: execPath}
+
+	var noPrevErr *NoPreviousError
+	if err := u.Rollback(); !errors.As(err, &noPrevErr) {
+		t.Fatalf("Rollback() without a previous binary = %v, want NoPreviousError", err)
+	}
+
+	// An update keeps the replaced binary as .prev
+	newBinary := filepath.Join(dir, "download")
+	writeFakeBinary(t, newBinary, "v1.1.0")
+	if err := u.AtomicReplace(newBinary); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(execPath + ".old"); !os.IsNotExist(err) {
+		t.Errorf(".old backup should be gone, stat err = %v", err)
+	}
+	if got, err := u.CheckPrevious(); err != nil || got != "zap version v1.0.0" {
+		t.Fatalf("CheckPrevious() = %q, %v", got, err)
+	}
+
+	// A previous binary that is not executable is refused
+	if err := os.Chmod(u.PreviousPath(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := u.Rollback(); err == nil {
+		t.Error("Rollback() should refuse a non-executable previous binary")
+	}
+	if err := os.Chmod(u.PreviousPath(), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := u.Rollback(); err != nil {
+		t.Fatalf("Rollback() = %v", err)
+	}
+	content, err := os.ReadFile(execPath)
+	if err != nil || string(content) != "#!/bin/sh\necho zap version v1.0.0\n" {
+		t.Errorf("restored binary = %q, %v", content, err)
+	}
+
+	// Only one version is kept
+	if err := u.Rollback(); !errors.As(err, &noPrevErr) {
+		t.Errorf("second Rollback() = %v, want NoPreviousError", err)
+	}
+}